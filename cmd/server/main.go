@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"flag"
 	"fmt"
 	"net/http"
 	"os"
@@ -12,15 +14,25 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/web-dev-jesus/trendzone/config"
+	"github.com/web-dev-jesus/trendzone/internal/analytics"
 	"github.com/web-dev-jesus/trendzone/internal/api/handlers"
 	"github.com/web-dev-jesus/trendzone/internal/api/routes"
 	"github.com/web-dev-jesus/trendzone/internal/db/mongodb"
 	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+	"github.com/web-dev-jesus/trendzone/internal/events"
 	"github.com/web-dev-jesus/trendzone/internal/logger"
+	"github.com/web-dev-jesus/trendzone/internal/projections"
+	"github.com/web-dev-jesus/trendzone/internal/ratings"
+	"github.com/web-dev-jesus/trendzone/internal/scheduler"
 	"github.com/web-dev-jesus/trendzone/internal/sportsdata"
 )
 
 func main() {
+	// Parse command line flags
+	ensureIndexesOnly := flag.Bool("ensure-indexes", false, "Ensure MongoDB indexes, print the report and exit")
+	pruneIndexes := flag.Bool("prune-indexes", false, "Drop indexes that are not declared in the index registry")
+	flag.Parse()
+
 	// Create a root context
 	ctx := context.Background()
 
@@ -43,15 +55,45 @@ func main() {
 		log.WithError(err).Fatal("Failed to connect to MongoDB")
 	}
 
+	// Ensure the indexes declared by the index registry
+	indexReport, err := mongodb.EnsureIndexes(ctx, mongoClient.GetDatabase(), *pruneIndexes)
+	if *ensureIndexesOnly {
+		report, _ := json.MarshalIndent(indexReport, "", "  ")
+		fmt.Println(string(report))
+		if closeErr := mongoClient.Close(ctx); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close MongoDB connection")
+		}
+		if err != nil {
+			log.WithError(err).Fatal("Failed to ensure MongoDB indexes")
+		}
+		return
+	}
+	if err != nil {
+		log.WithError(err).Error("Failed to ensure some MongoDB indexes")
+	}
+
 	// Create repositories
 	teamsRepo := repositories.NewTeamsRepository(mongoClient.GetDatabase())
 	playersRepo := repositories.NewPlayersRepository(mongoClient.GetDatabase())
 	gamesRepo := repositories.NewGamesRepository(mongoClient.GetDatabase())
 	standingsRepo := repositories.NewStandingsRepository(mongoClient.GetDatabase())
 	schedulesRepo := repositories.NewSchedulesRepository(mongoClient.GetDatabase())
+	syncJobsRepo := repositories.NewSyncJobsRepository(mongoClient.GetDatabase())
+	responseCacheRepo := repositories.NewResponseCacheRepository(mongoClient.GetDatabase())
+	playerGameStatsRepo := repositories.NewPlayerGameStatsRepository(mongoClient.GetDatabase())
+	teamGameStatsRepo := repositories.NewTeamGameStatsRepository(mongoClient.GetDatabase())
+	teamSeasonStatsRepo := repositories.NewTeamSeasonStatsRepository(mongoClient.GetDatabase())
+	injuriesRepo := repositories.NewInjuriesRepository(mongoClient.GetDatabase())
+	depthChartsRepo := repositories.NewDepthChartsRepository(mongoClient.GetDatabase())
+	playByPlayRepo := repositories.NewPlayByPlayRepository(mongoClient.GetDatabase())
+	stadiumsRepo := repositories.NewStadiumsRepository(mongoClient.GetDatabase())
+	oddsRepo := repositories.NewOddsRepository(mongoClient.GetDatabase())
+
+	// Create the broker that fans out data change events to streaming clients
+	eventBroker := events.NewBroker(1000)
 
 	// Create SportsData.io client and service
-	sportsDataClient := sportsdata.NewClient(&cfg.SportsData)
+	sportsDataClient := sportsdata.NewClient(&cfg.SportsData, responseCacheRepo)
 	sportsDataService := sportsdata.NewService(
 		sportsDataClient,
 		teamsRepo,
@@ -59,8 +101,54 @@ func main() {
 		standingsRepo,
 		schedulesRepo,
 		gamesRepo,
+		syncJobsRepo,
+		eventBroker,
+		playerGameStatsRepo,
+		teamGameStatsRepo,
+		teamSeasonStatsRepo,
+		injuriesRepo,
+		depthChartsRepo,
+		playByPlayRepo,
+		stadiumsRepo,
+		oddsRepo,
 	)
 
+	// Create the analytics service that computes trends from the stored games, and keep the
+	// streaks up to date with every games sync. Hooks must be registered before any sync runs.
+	analyticsService := analytics.NewService(mongoClient.GetDatabase())
+	sportsDataService.OnGamesSynced(analyticsService.UpdateStreaks)
+
+	// Create the ratings service and keep the Elo ratings up to date with every games sync
+	ratingsService := ratings.NewService(mongoClient.GetDatabase())
+	sportsDataService.OnGamesSynced(ratingsService.UpdateRatings)
+
+	// Rebuild the streaks and ratings from the stored games in case games changed while the server was down
+	go func() {
+		if err := analyticsService.RecomputeStreaks(ctx); err != nil {
+			log.WithError(err).Error("Failed to recompute streaks")
+		}
+		if _, err := ratingsService.Recompute(ctx); err != nil {
+			log.WithError(err).Error("Failed to recompute ratings")
+		}
+	}()
+
+	// Create the projections service, whose cached simulations are dropped whenever games or schedules change
+	projectionsService := projections.NewService(teamsRepo, gamesRepo, schedulesRepo)
+	sportsDataService.OnGamesSynced(projectionsService.GamesSynced)
+	sportsDataService.OnSchedulesSynced(projectionsService.SchedulesSynced)
+
+	// Start the recurring sync scheduler
+	syncScheduler := scheduler.NewScheduler(cfg, sportsDataService, schedulesRepo)
+	if cfg.Scheduler.Enabled {
+		syncScheduler.Start(ctx)
+	}
+
+	// Start polling live scores while games are in progress
+	livePoller := scheduler.NewLivePoller(cfg, sportsDataService, schedulesRepo, gamesRepo)
+	if cfg.Live.Enabled {
+		livePoller.Start(ctx)
+	}
+
 	// Create handler
 	handler := handlers.NewHandler(
 		cfg,
@@ -69,7 +157,20 @@ func main() {
 		gamesRepo,
 		standingsRepo,
 		schedulesRepo,
+		syncJobsRepo,
+		playerGameStatsRepo,
+		teamGameStatsRepo,
+		teamSeasonStatsRepo,
+		injuriesRepo,
+		depthChartsRepo,
+		playByPlayRepo,
+		stadiumsRepo,
+		oddsRepo,
 		sportsDataService,
+		analyticsService,
+		projectionsService,
+		ratingsService,
+		eventBroker,
 	)
 
 	// Setup router
@@ -80,6 +181,8 @@ func main() {
 		Addr:    fmt.Sprintf(":%s", cfg.App.Port),
 		Handler: router,
 	}
+	// Shutdown waits for open requests, so end the event streams when it starts
+	server.RegisterOnShutdown(eventBroker.Close)
 
 	// Start the server in a goroutine
 	go func() {
@@ -102,7 +205,15 @@ func main() {
 
 	// Shutdown the server
 	if err := server.Shutdown(ctx); err != nil {
-		log.WithError(err).Fatal("Server forced to shutdown")
+		log.WithError(err).Error("Server forced to shutdown")
+	}
+
+	// Stop the scheduler and live poller and wait for in-flight syncs
+	if err := syncScheduler.Stop(ctx); err != nil {
+		log.WithError(err).Error("Failed to stop sync scheduler cleanly")
+	}
+	if err := livePoller.Stop(ctx); err != nil {
+		log.WithError(err).Error("Failed to stop live score poller cleanly")
 	}
 
 	// Close MongoDB connection
@@ -3,6 +3,8 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -13,6 +15,9 @@ type Config struct {
 	App        AppConfig
 	MongoDB    MongoDBConfig
 	SportsData SportsDataConfig
+	Scheduler  SchedulerConfig
+	Live       LiveConfig
+	WebSocket  WebSocketConfig
 }
 
 type AppConfig struct {
@@ -31,6 +36,40 @@ type MongoDBConfig struct {
 type SportsDataConfig struct {
 	APIKey  string
 	BaseURL string
+	Season  string
+	Timeout time.Duration
+	// Retries of a request after a 429, 5xx or network error, with exponential backoff between RetryBaseDelay and RetryMaxDelay
+	MaxRetries     int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// Client-side token bucket sized to the SportsData.io plan
+	RateLimitPerMinute int
+	RateLimitBurst     int
+}
+
+type SchedulerConfig struct {
+	Enabled              bool
+	TeamsInterval        time.Duration
+	PlayersInterval      time.Duration
+	StandingsInterval    time.Duration
+	SchedulesInterval    time.Duration
+	GamesInterval        time.Duration
+	GamesGameDayInterval time.Duration
+	Jitter               time.Duration
+}
+
+type WebSocketConfig struct {
+	MaxSubscriptions              int
+	MaxSubscriptionsAuthenticated int
+	AllowedOrigins                []string
+}
+
+type LiveConfig struct {
+	Enabled         bool
+	PollInterval    time.Duration
+	IdleInterval    time.Duration
+	PregameLead     time.Duration
+	MaxGameDuration time.Duration
 }
 
 func Load() (*Config, error) {
@@ -66,8 +105,37 @@ func Load() (*Config, error) {
 			Timeout: timeout,
 		},
 		SportsData: SportsDataConfig{
-			APIKey:  getEnv("SPORTSDATA_API_KEY", ""),
-			BaseURL: getEnv("SPORTSDATA_API_BASE_URL", "https://api.sportsdata.io/v3/nfl"),
+			APIKey:             getEnv("SPORTSDATA_API_KEY", ""),
+			BaseURL:            getEnv("SPORTSDATA_API_BASE_URL", "https://api.sportsdata.io/v3/nfl"),
+			Season:             getEnv("SPORTSDATA_SEASON", "2023"),
+			Timeout:            getDuration("SPORTSDATA_TIMEOUT", 30*time.Second),
+			MaxRetries:         getInt("SPORTSDATA_MAX_RETRIES", 3),
+			RetryBaseDelay:     getDuration("SPORTSDATA_RETRY_BASE_DELAY", 500*time.Millisecond),
+			RetryMaxDelay:      getDuration("SPORTSDATA_RETRY_MAX_DELAY", 30*time.Second),
+			RateLimitPerMinute: getInt("SPORTSDATA_RATE_LIMIT_PER_MINUTE", 60),
+			RateLimitBurst:     getInt("SPORTSDATA_RATE_LIMIT_BURST", 10),
+		},
+		Scheduler: SchedulerConfig{
+			Enabled:              getEnv("SCHEDULER_ENABLED", "false") == "true",
+			TeamsInterval:        getDuration("SCHEDULER_TEAMS_INTERVAL", 24*time.Hour),
+			PlayersInterval:      getDuration("SCHEDULER_PLAYERS_INTERVAL", time.Hour),
+			StandingsInterval:    getDuration("SCHEDULER_STANDINGS_INTERVAL", 24*time.Hour),
+			SchedulesInterval:    getDuration("SCHEDULER_SCHEDULES_INTERVAL", 12*time.Hour),
+			GamesInterval:        getDuration("SCHEDULER_GAMES_INTERVAL", 6*time.Hour),
+			GamesGameDayInterval: getDuration("SCHEDULER_GAMES_GAMEDAY_INTERVAL", 5*time.Minute),
+			Jitter:               getDuration("SCHEDULER_JITTER", 30*time.Second),
+		},
+		Live: LiveConfig{
+			Enabled:         getEnv("LIVE_ENABLED", "false") == "true",
+			PollInterval:    getDuration("LIVE_POLL_INTERVAL", 15*time.Second),
+			IdleInterval:    getDuration("LIVE_IDLE_INTERVAL", 5*time.Minute),
+			PregameLead:     getDuration("LIVE_PREGAME_LEAD", 15*time.Minute),
+			MaxGameDuration: getDuration("LIVE_MAX_GAME_DURATION", 5*time.Hour),
+		},
+		WebSocket: WebSocketConfig{
+			MaxSubscriptions:              getInt("WS_MAX_SUBSCRIPTIONS", 10),
+			MaxSubscriptionsAuthenticated: getInt("WS_MAX_SUBSCRIPTIONS_AUTHENTICATED", 50),
+			AllowedOrigins:                getList("WS_ALLOWED_ORIGINS"),
 		},
 	}, nil
 }
@@ -79,3 +147,42 @@ func getEnv(key, defaultValue string) string {
 	}
 	return value
 }
+
+// getDuration parses a Go duration string (e.g. "15m", "24h") from the environment
+func getDuration(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		logrus.WithField("key", key).Warn("Invalid duration, using default")
+		return defaultValue
+	}
+	return d
+}
+
+// getInt parses an integer from the environment
+func getInt(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		logrus.WithField("key", key).Warn("Invalid integer, using default")
+		return defaultValue
+	}
+	return i
+}
+
+// getList parses a comma-separated list from the environment
+func getList(key string) []string {
+	list := []string{}
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
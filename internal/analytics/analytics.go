@@ -0,0 +1,83 @@
+// Package analytics computes betting and performance trends from the stored games
+package analytics
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+)
+
+type Service struct {
+	games   *mongo.Collection
+	streaks *mongo.Collection
+}
+
+func NewService(client *mongo.Database) *Service {
+	return &Service{
+		games:   client.Collection("games"),
+		streaks: client.Collection("streaks"),
+	}
+}
+
+// finalGames matches the games of a season whose score will no longer change
+func finalGames(season int, seasonType int) bson.M {
+	return bson.M{
+		"Season":     season,
+		"SeasonType": seasonType,
+		"Status":     bson.M{"$in": []string{models.GameStatusFinal, models.GameStatusFinalOT}},
+	}
+}
+
+// teamSides turns each game into one document per team, seen from that team's side:
+// Team, Opponent, Home, NeutralVenue, Date, Week, PointsFor, PointsAgainst, Margin, Spread (negative when the team is favored),
+// OverUnder and Total. Spread and OverUnder are null when the game has no line.
+func teamSides() []bson.D {
+	side := func(team string, opponent string, pointsFor string, pointsAgainst string, home bool, spreadSign int) bson.M {
+		return bson.M{
+			"Team":          team,
+			"Opponent":      opponent,
+			"Home":          bson.M{"$literal": home},
+			"NeutralVenue":  "$NeutralVenue",
+			"Date":          "$Date",
+			"Week":          "$Week",
+			"GameKey":       "$GameKey",
+			"PointsFor":     pointsFor,
+			"PointsAgainst": pointsAgainst,
+			"Margin":        bson.M{"$subtract": bson.A{pointsFor, pointsAgainst}},
+			"Spread":        bson.M{"$cond": bson.A{"$HasLine", bson.M{"$multiply": bson.A{"$PointSpread", spreadSign}}, nil}},
+			"OverUnder":     bson.M{"$cond": bson.A{bson.M{"$gt": bson.A{"$OverUnder", 0}}, "$OverUnder", nil}},
+			"Total":         bson.M{"$add": bson.A{"$HomeScore", "$AwayScore"}},
+		}
+	}
+
+	return []bson.D{
+		// A pick'em spread is 0 too, so a game only has no line when the over/under is missing as well
+		{{Key: "$addFields", Value: bson.M{
+			"HasLine": bson.M{"$or": bson.A{
+				bson.M{"$ne": bson.A{"$PointSpread", 0}},
+				bson.M{"$gt": bson.A{"$OverUnder", 0}},
+			}},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id": 0,
+			"Sides": bson.A{
+				side("$HomeTeam", "$AwayTeam", "$HomeScore", "$AwayScore", true, 1),
+				side("$AwayTeam", "$HomeTeam", "$AwayScore", "$HomeScore", false, -1),
+			},
+		}}},
+		{{Key: "$unwind", Value: "$Sides"}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$Sides"}}},
+		// CoverMargin is by how much the team beat the spread, TotalMargin by how much the total beat the over/under
+		{{Key: "$addFields", Value: bson.M{
+			"CoverMargin": bson.M{"$cond": bson.A{
+				bson.M{"$eq": bson.A{"$Spread", nil}}, nil,
+				bson.M{"$add": bson.A{"$Margin", "$Spread"}},
+			}},
+			"TotalMargin": bson.M{"$cond": bson.A{
+				bson.M{"$eq": bson.A{"$OverUnder", nil}}, nil,
+				bson.M{"$subtract": bson.A{"$Total", "$OverUnder"}},
+			}},
+		}}},
+	}
+}
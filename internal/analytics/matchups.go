@@ -0,0 +1,151 @@
+package analytics
+
+import (
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+)
+
+// MatchupSplit is a team's results over a subset of its games against one opponent
+type MatchupSplit struct {
+	TrendSplit
+	// AvgMargin is by how many points the team outscored the opponent on average
+	AvgMargin float64 `json:"avgMargin"`
+}
+
+// Matchup is the head-to-head history of a team against an opponent, from the team's side
+type Matchup struct {
+	Team     string `json:"team"`
+	Opponent string `json:"opponent"`
+	// AllTime covers every meeting summarized, Recent the meetings of the seasons RecentFrom to RecentTo
+	AllTime    MatchupSplit `json:"allTime"`
+	Recent     MatchupSplit `json:"recent"`
+	RecentFrom int          `json:"recentFrom"`
+	RecentTo   int          `json:"recentTo"`
+	// Home and Away are the meetings at the team's and at the opponent's stadium; meetings at a neutral
+	// venue count in neither
+	Home MatchupSplit `json:"home"`
+	Away MatchupSplit `json:"away"`
+}
+
+// SummarizeMatchup computes the head-to-head records of team against opponent from their final
+// meetings. Meetings of the seasons recentFrom to recentTo count as recent.
+func SummarizeMatchup(team string, opponent string, games []models.Game, recentFrom int, recentTo int) *Matchup {
+	var all, recent, home, away []teamGame
+	for i := range games {
+		game := sideOf(&games[i], team)
+		all = append(all, game)
+		if games[i].Season >= recentFrom && games[i].Season <= recentTo {
+			recent = append(recent, game)
+		}
+		switch {
+		case games[i].NeutralVenue:
+		case game.Home:
+			home = append(home, game)
+		default:
+			away = append(away, game)
+		}
+	}
+
+	return &Matchup{
+		Team:       team,
+		Opponent:   opponent,
+		AllTime:    summarize(all),
+		Recent:     summarize(recent),
+		RecentFrom: recentFrom,
+		RecentTo:   recentTo,
+		Home:       summarize(home),
+		Away:       summarize(away),
+	}
+}
+
+// sideOf sees a game from one team's side the way teamSides does
+func sideOf(game *models.Game, team string) teamGame {
+	home := game.HomeTeam == team
+	side := teamGame{
+		Team:          team,
+		Home:          home,
+		GameKey:       game.GameKey,
+		Date:          game.Date,
+		PointsFor:     game.HomeScore,
+		PointsAgainst: game.AwayScore,
+	}
+	spread := game.PointSpread
+	if !home {
+		side.PointsFor, side.PointsAgainst = game.AwayScore, game.HomeScore
+		spread = -spread
+	}
+	side.Margin = side.PointsFor - side.PointsAgainst
+
+	// A pick'em spread is 0 too, so a game only has no line when the over/under is missing as well
+	if game.PointSpread != 0 || game.OverUnder > 0 {
+		cover := float64(side.Margin) + spread
+		side.CoverMargin = &cover
+	}
+	if game.OverUnder > 0 {
+		total := float64(game.HomeScore+game.AwayScore) - game.OverUnder
+		side.TotalMargin = &total
+	}
+	return side
+}
+
+// summarize totals the results of a team's games
+func summarize(games []teamGame) MatchupSplit {
+	var split MatchupSplit
+	var margin, coverMargin, totalMargin float64
+	var lines, totals int
+
+	for _, game := range games {
+		split.Games++
+		margin += float64(game.Margin)
+		switch {
+		case game.Margin > 0:
+			split.Wins++
+		case game.Margin < 0:
+			split.Losses++
+		default:
+			split.Ties++
+		}
+
+		if game.CoverMargin != nil {
+			lines++
+			coverMargin += *game.CoverMargin
+			switch {
+			case *game.CoverMargin > 0:
+				split.ATS.Wins++
+			case *game.CoverMargin < 0:
+				split.ATS.Losses++
+			default:
+				split.ATS.Pushes++
+			}
+		}
+
+		if game.TotalMargin != nil {
+			totals++
+			totalMargin += *game.TotalMargin
+			switch {
+			case *game.TotalMargin > 0:
+				split.OverUnder.Overs++
+			case *game.TotalMargin < 0:
+				split.OverUnder.Unders++
+			default:
+				split.OverUnder.Pushes++
+			}
+		}
+	}
+
+	if split.Games > 0 {
+		split.AvgMargin = round(margin / float64(split.Games))
+	}
+	if decided := split.ATS.Wins + split.ATS.Losses; decided > 0 {
+		split.ATS.CoverPct = round(float64(split.ATS.Wins) / float64(decided))
+	}
+	if lines > 0 {
+		split.ATS.AvgCoverMargin = round(coverMargin / float64(lines))
+	}
+	if decided := split.OverUnder.Overs + split.OverUnder.Unders; decided > 0 {
+		split.OverUnder.OverPct = round(float64(split.OverUnder.Overs) / float64(decided))
+	}
+	if totals > 0 {
+		split.OverUnder.AvgTotalMargin = round(totalMargin / float64(totals))
+	}
+	return split
+}
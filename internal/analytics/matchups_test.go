@@ -0,0 +1,137 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+)
+
+var testMeetings = []models.Game{
+	{GameKey: "202310105", Season: 2023, HomeTeam: "BUF", AwayTeam: "MIA", HomeScore: 24, AwayScore: 17, PointSpread: -3, OverUnder: 45},
+	{GameKey: "202210112", Season: 2022, HomeTeam: "MIA", AwayTeam: "BUF", HomeScore: 31, AwayScore: 10, PointSpread: -2.5, OverUnder: 40},
+	{GameKey: "201810107", Season: 2018, HomeTeam: "BUF", AwayTeam: "MIA", HomeScore: 20, AwayScore: 20},
+}
+
+func TestSummarizeMatchup(t *testing.T) {
+	tests := []struct {
+		name  string
+		team  string
+		split func(m *Matchup) MatchupSplit
+		want  MatchupSplit
+	}{
+		{
+			name:  "all time",
+			team:  "BUF",
+			split: func(m *Matchup) MatchupSplit { return m.AllTime },
+			want: MatchupSplit{
+				TrendSplit: TrendSplit{
+					Games: 3, Wins: 1, Losses: 1, Ties: 1,
+					ATS:       ATSRecord{Wins: 1, Losses: 1, CoverPct: 0.5, AvgCoverMargin: -7.25},
+					OverUnder: OverUnderRecord{Overs: 1, Unders: 1, OverPct: 0.5, AvgTotalMargin: -1.5},
+				},
+				AvgMargin: -4.667,
+			},
+		},
+		{
+			name:  "recent",
+			team:  "BUF",
+			split: func(m *Matchup) MatchupSplit { return m.Recent },
+			want: MatchupSplit{
+				TrendSplit: TrendSplit{
+					Games: 2, Wins: 1, Losses: 1,
+					ATS:       ATSRecord{Wins: 1, Losses: 1, CoverPct: 0.5, AvgCoverMargin: -7.25},
+					OverUnder: OverUnderRecord{Overs: 1, Unders: 1, OverPct: 0.5, AvgTotalMargin: -1.5},
+				},
+				AvgMargin: -7,
+			},
+		},
+		{
+			name:  "home",
+			team:  "BUF",
+			split: func(m *Matchup) MatchupSplit { return m.Home },
+			want: MatchupSplit{
+				TrendSplit: TrendSplit{
+					Games: 2, Wins: 1, Ties: 1,
+					ATS:       ATSRecord{Wins: 1, CoverPct: 1, AvgCoverMargin: 4},
+					OverUnder: OverUnderRecord{Unders: 1, AvgTotalMargin: -4},
+				},
+				AvgMargin: 3.5,
+			},
+		},
+		{
+			name:  "away",
+			team:  "BUF",
+			split: func(m *Matchup) MatchupSplit { return m.Away },
+			want: MatchupSplit{
+				TrendSplit: TrendSplit{
+					Games: 1, Losses: 1,
+					ATS:       ATSRecord{Losses: 1, AvgCoverMargin: -18.5},
+					OverUnder: OverUnderRecord{Overs: 1, OverPct: 1, AvgTotalMargin: 1},
+				},
+				AvgMargin: -21,
+			},
+		},
+		{
+			name:  "all time from the opponent's side",
+			team:  "MIA",
+			split: func(m *Matchup) MatchupSplit { return m.AllTime },
+			want: MatchupSplit{
+				TrendSplit: TrendSplit{
+					Games: 3, Wins: 1, Losses: 1, Ties: 1,
+					ATS:       ATSRecord{Wins: 1, Losses: 1, CoverPct: 0.5, AvgCoverMargin: 7.25},
+					OverUnder: OverUnderRecord{Overs: 1, Unders: 1, OverPct: 0.5, AvgTotalMargin: -1.5},
+				},
+				AvgMargin: 4.667,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opponent := "MIA"
+			if tt.team == "MIA" {
+				opponent = "BUF"
+			}
+			matchup := SummarizeMatchup(tt.team, opponent, testMeetings, 2022, 2023)
+			if got := tt.split(matchup); got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummarizeMatchupWithoutMeetings(t *testing.T) {
+	matchup := SummarizeMatchup("BUF", "MIA", nil, 2022, 2023)
+	if matchup.AllTime != (MatchupSplit{}) || matchup.Recent != (MatchupSplit{}) || matchup.RecentFrom != 2022 || matchup.RecentTo != 2023 {
+		t.Errorf("got %+v, want empty splits", matchup)
+	}
+}
+
+func TestSummarizeMatchupSplits(t *testing.T) {
+	games := []models.Game{
+		{Season: 2024, HomeTeam: "BUF", AwayTeam: "MIA", HomeScore: 21, AwayScore: 14},
+		{Season: 2023, HomeTeam: "MIA", AwayTeam: "BUF", HomeScore: 21, AwayScore: 14, NeutralVenue: true},
+		{Season: 2022, HomeTeam: "MIA", AwayTeam: "BUF", HomeScore: 10, AwayScore: 13},
+		{Season: 2021, HomeTeam: "BUF", AwayTeam: "MIA", HomeScore: 35, AwayScore: 0},
+	}
+
+	tests := []struct {
+		name  string
+		split func(m *Matchup) MatchupSplit
+		games int
+	}{
+		{name: "all time", split: func(m *Matchup) MatchupSplit { return m.AllTime }, games: 4},
+		{name: "recent leaves out later seasons", split: func(m *Matchup) MatchupSplit { return m.Recent }, games: 2},
+		{name: "home leaves out neutral venues", split: func(m *Matchup) MatchupSplit { return m.Home }, games: 2},
+		{name: "away leaves out neutral venues", split: func(m *Matchup) MatchupSplit { return m.Away }, games: 1},
+	}
+
+	matchup := SummarizeMatchup("BUF", "MIA", games, 2022, 2023)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.split(matchup).Games; got != tt.games {
+				t.Errorf("got %d games, want %d", got, tt.games)
+			}
+		})
+	}
+}
@@ -0,0 +1,362 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// Streak types
+const (
+	StreakWin            = "win"
+	StreakLoss           = "loss"
+	StreakATSCover       = "ats_cover"
+	StreakATSMiss        = "ats_miss"
+	StreakOver           = "over"
+	StreakUnder          = "under"
+	StreakScored20       = "scored_20"
+	StreakScored30       = "scored_30"
+	StreakAllowedUnder20 = "allowed_under_20"
+)
+
+// streakTypes lists every streak type with the test a game must pass to extend it. A game the test
+// doesn't apply to, such as an against-the-spread push, neither extends nor breaks the streak.
+var streakTypes = []struct {
+	name string
+	test func(game *teamGame) (extends bool, applies bool)
+}{
+	{StreakWin, func(g *teamGame) (bool, bool) { return g.Margin > 0, true }},
+	{StreakLoss, func(g *teamGame) (bool, bool) { return g.Margin < 0, true }},
+	{StreakATSCover, func(g *teamGame) (bool, bool) { return decided(g.CoverMargin, 1) }},
+	{StreakATSMiss, func(g *teamGame) (bool, bool) { return decided(g.CoverMargin, -1) }},
+	{StreakOver, func(g *teamGame) (bool, bool) { return decided(g.TotalMargin, 1) }},
+	{StreakUnder, func(g *teamGame) (bool, bool) { return decided(g.TotalMargin, -1) }},
+	{StreakScored20, func(g *teamGame) (bool, bool) { return g.PointsFor >= 20, true }},
+	{StreakScored30, func(g *teamGame) (bool, bool) { return g.PointsFor >= 30, true }},
+	{StreakAllowedUnder20, func(g *teamGame) (bool, bool) { return g.PointsAgainst < 20, true }},
+}
+
+// decided reports whether a margin has the given sign; a missing or zero margin doesn't apply
+func decided(margin *float64, sign float64) (bool, bool) {
+	if margin == nil || *margin == 0 {
+		return false, false
+	}
+	return *margin*sign > 0, true
+}
+
+// IsStreakType reports whether streakType names a streak type
+func IsStreakType(streakType string) bool {
+	for _, t := range streakTypes {
+		if t.name == streakType {
+			return true
+		}
+	}
+	return false
+}
+
+// Streak is a team's current and longest run of games of one type, over every stored regular season
+// and postseason game. A current streak of 0 means the team's last counted game broke it; of two equally
+// long runs the earlier one is the longest.
+type Streak struct {
+	Team             string    `bson:"Team" json:"team"`
+	Type             string    `bson:"Type" json:"type"`
+	Current          int       `bson:"Current" json:"current"`
+	CurrentStartDate time.Time `bson:"CurrentStartDate" json:"currentStartDate"`
+	Longest          int       `bson:"Longest" json:"longest"`
+	LongestStartDate time.Time `bson:"LongestStartDate" json:"longestStartDate"`
+	LongestEndDate   time.Time `bson:"LongestEndDate" json:"longestEndDate"`
+	LastGameKey      string    `bson:"LastGameKey" json:"lastGameKey"`
+	LastGameDate     time.Time `bson:"LastGameDate" json:"lastGameDate"`
+	UpdatedAt        time.Time `bson:"UpdatedAt" json:"updatedAt"`
+}
+
+// StreakFilter selects streaks for Streaks. Every set field is ANDed together.
+type StreakFilter struct {
+	Team  string
+	Types []string
+	// MinLength matches streaks whose current length is at least this long
+	MinLength int
+}
+
+// teamGame is a final game from one team's side, as produced by teamSides
+type teamGame struct {
+	Team          string    `bson:"Team"`
+	Home          bool      `bson:"Home"`
+	GameKey       string    `bson:"GameKey"`
+	Date          time.Time `bson:"Date"`
+	PointsFor     int       `bson:"PointsFor"`
+	PointsAgainst int       `bson:"PointsAgainst"`
+	Margin        int       `bson:"Margin"`
+	CoverMargin   *float64  `bson:"CoverMargin"`
+	TotalMargin   *float64  `bson:"TotalMargin"`
+}
+
+// Streaks lists the stored streaks, longest current streak first
+func (s *Service) Streaks(ctx context.Context, filter *StreakFilter) ([]Streak, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component":  "analytics.Streaks",
+		"team":       filter.Team,
+		"min_length": filter.MinLength,
+	})
+	log.Info("Listing streaks")
+
+	query := bson.M{}
+	if filter.Team != "" {
+		query["Team"] = filter.Team
+	}
+	if len(filter.Types) > 0 {
+		query["Type"] = bson.M{"$in": filter.Types}
+	}
+	if filter.MinLength > 0 {
+		query["Current"] = bson.M{"$gte": filter.MinLength}
+	}
+
+	opts := options.Find().SetSort(bson.D{
+		{Key: "Current", Value: -1},
+		{Key: "Longest", Value: -1},
+		{Key: "Team", Value: 1},
+		{Key: "Type", Value: 1},
+	})
+
+	streaks := []Streak{}
+	cursor, err := s.streaks.Find(ctx, query, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to find streaks")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &streaks); err != nil {
+		log.WithError(err).Error("Failed to decode streaks")
+		return nil, err
+	}
+
+	log.WithField("count", len(streaks)).Info("Streaks retrieved successfully")
+	return streaks, nil
+}
+
+// UpdateStreaks brings the streaks of the teams that played in the given games up to date. It is meant to
+// run after every games sync with the games the sync changed. A team's new final games are applied on top
+// of its stored streaks; if one of them is not newer than the last game already counted, such as a
+// corrected score, the team's streaks are recomputed from all of its games instead.
+func (s *Service) UpdateStreaks(ctx context.Context, games []models.Game) error {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "analytics.UpdateStreaks",
+		"games":     len(games),
+	})
+
+	// The earliest changed final game of each team
+	since := map[string]time.Time{}
+	for _, game := range games {
+		if !countsForStreaks(&game) {
+			continue
+		}
+		for _, team := range []string{game.HomeTeam, game.AwayTeam} {
+			if first, ok := since[team]; !ok || game.Date.Before(first) {
+				since[team] = game.Date
+			}
+		}
+	}
+	if len(since) == 0 {
+		return nil
+	}
+
+	log.WithField("teams", len(since)).Info("Updating streaks")
+
+	for team, first := range since {
+		stored, err := s.teamStreaks(ctx, team)
+		if err != nil {
+			log.WithError(err).WithField("team", team).Error("Failed to load stored streaks")
+			return err
+		}
+
+		var lastCounted time.Time
+		for _, streak := range stored {
+			lastCounted = streak.LastGameDate
+			break
+		}
+
+		var after *time.Time
+		if len(stored) == len(streakTypes) && first.After(lastCounted) {
+			after = &lastCounted
+		} else {
+			stored = map[string]*Streak{}
+		}
+
+		teamGames, err := s.teamGames(ctx, team, after)
+		if err != nil {
+			log.WithError(err).WithField("team", team).Error("Failed to load team games")
+			return err
+		}
+
+		streaks := applyGames(team, stored, teamGames)
+		if err := s.saveStreaks(ctx, streaks); err != nil {
+			log.WithError(err).WithField("team", team).Error("Failed to save streaks")
+			return err
+		}
+	}
+
+	log.Info("Streaks updated successfully")
+	return nil
+}
+
+// RecomputeStreaks recomputes the streaks of every team from all of the stored games
+func (s *Service) RecomputeStreaks(ctx context.Context) error {
+	log := logger.WithRequestContext(ctx).WithField("component", "analytics.RecomputeStreaks")
+	log.Info("Recomputing streaks")
+
+	teamGames, err := s.teamGames(ctx, "", nil)
+	if err != nil {
+		log.WithError(err).Error("Failed to load games")
+		return err
+	}
+
+	byTeam := map[string][]teamGame{}
+	for _, game := range teamGames {
+		byTeam[game.Team] = append(byTeam[game.Team], game)
+	}
+
+	streaks := []Streak{}
+	for team, games := range byTeam {
+		streaks = append(streaks, applyGames(team, map[string]*Streak{}, games)...)
+	}
+	if err := s.saveStreaks(ctx, streaks); err != nil {
+		log.WithError(err).Error("Failed to save streaks")
+		return err
+	}
+
+	log.WithField("teams", len(byTeam)).Info("Streaks recomputed successfully")
+	return nil
+}
+
+// countsForStreaks reports whether a game is a final regular season or postseason game
+func countsForStreaks(game *models.Game) bool {
+	if game.SeasonType == 2 {
+		return false
+	}
+	return game.Status == models.GameStatusFinal || game.Status == models.GameStatusFinalOT
+}
+
+// applyGames extends a team's streaks with its games, which must be in date order and newer than the
+// games already counted. Missing streak types start empty.
+func applyGames(team string, stored map[string]*Streak, games []teamGame) []Streak {
+	now := time.Now()
+	streaks := make([]Streak, 0, len(streakTypes))
+	for _, streakType := range streakTypes {
+		streak := Streak{Team: team, Type: streakType.name}
+		if old := stored[streakType.name]; old != nil {
+			streak = *old
+		}
+
+		for i := range games {
+			game := &games[i]
+			streak.LastGameKey = game.GameKey
+			streak.LastGameDate = game.Date
+
+			extends, applies := streakType.test(game)
+			if !applies {
+				continue
+			}
+			if !extends {
+				streak.Current = 0
+				continue
+			}
+
+			if streak.Current == 0 {
+				streak.CurrentStartDate = game.Date
+			}
+			streak.Current++
+			if streak.Current > streak.Longest {
+				streak.Longest = streak.Current
+				streak.LongestStartDate = streak.CurrentStartDate
+				streak.LongestEndDate = game.Date
+			}
+		}
+
+		streak.UpdatedAt = now
+		streaks = append(streaks, streak)
+	}
+	return streaks
+}
+
+// teamStreaks loads a team's stored streaks keyed by type
+func (s *Service) teamStreaks(ctx context.Context, team string) (map[string]*Streak, error) {
+	cursor, err := s.streaks.Find(ctx, bson.M{"Team": team})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var streaks []Streak
+	if err := cursor.All(ctx, &streaks); err != nil {
+		return nil, err
+	}
+
+	byType := make(map[string]*Streak, len(streaks))
+	for i := range streaks {
+		byType[streaks[i].Type] = &streaks[i]
+	}
+	return byType, nil
+}
+
+// teamGames loads the final regular season and postseason games of one team, or of every team when team
+// is empty, from each team's side in date order. With after set, only later games are loaded.
+func (s *Service) teamGames(ctx context.Context, team string, after *time.Time) ([]teamGame, error) {
+	match := bson.M{
+		"SeasonType": bson.M{"$ne": 2},
+		"Status":     bson.M{"$in": []string{models.GameStatusFinal, models.GameStatusFinalOT}},
+	}
+	if team != "" {
+		match["$or"] = []bson.M{{"HomeTeam": team}, {"AwayTeam": team}}
+	}
+	if after != nil {
+		match["Date"] = bson.M{"$gt": *after}
+	}
+
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: match}}}
+	pipeline = append(pipeline, teamSides()...)
+	if team != "" {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"Team": team}}})
+	}
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{{Key: "Date", Value: 1}, {Key: "GameKey", Value: 1}}}})
+
+	cursor, err := s.games.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	games := []teamGame{}
+	if err := cursor.All(ctx, &games); err != nil {
+		return nil, err
+	}
+	return games, nil
+}
+
+// saveStreaks replaces the stored streaks with the given ones
+func (s *Service) saveStreaks(ctx context.Context, streaks []Streak) error {
+	if len(streaks) == 0 {
+		return nil
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(streaks))
+	for i := range streaks {
+		writes = append(writes, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"Team": streaks[i].Team, "Type": streaks[i].Type}).
+			SetReplacement(&streaks[i]).
+			SetUpsert(true))
+	}
+
+	if _, err := s.streaks.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+		return fmt.Errorf("save streaks: %w", err)
+	}
+	return nil
+}
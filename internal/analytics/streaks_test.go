@@ -0,0 +1,140 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+)
+
+// played builds a team's games from their margins, one week apart. A nil cover margin means no line.
+func played(margins []int, coverMargins []*float64) []teamGame {
+	start := time.Date(2023, 9, 10, 17, 0, 0, 0, time.UTC)
+	games := make([]teamGame, len(margins))
+	for i, margin := range margins {
+		games[i] = teamGame{
+			Team:          "BUF",
+			GameKey:       string(rune('A' + i)),
+			Date:          start.AddDate(0, 0, 7*i),
+			PointsFor:     20 + margin,
+			PointsAgainst: 20,
+			Margin:        margin,
+		}
+		if i < len(coverMargins) {
+			games[i].CoverMargin = coverMargins[i]
+		}
+	}
+	return games
+}
+
+func margin(value float64) *float64 {
+	return &value
+}
+
+func streakOf(streaks []Streak, streakType string) Streak {
+	for _, streak := range streaks {
+		if streak.Type == streakType {
+			return streak
+		}
+	}
+	return Streak{}
+}
+
+func TestApplyGames(t *testing.T) {
+	tests := []struct {
+		name         string
+		games        []teamGame
+		streakType   string
+		current      int
+		longest      int
+		longestStart int
+	}{
+		{
+			name:       "no games",
+			streakType: StreakWin,
+		},
+		{
+			name:         "broken win streak",
+			games:        played([]int{3, 7, 10, -3}, nil),
+			streakType:   StreakWin,
+			current:      0,
+			longest:      3,
+			longestStart: 0,
+		},
+		{
+			name:         "current loss streak",
+			games:        played([]int{3, -7, -10}, nil),
+			streakType:   StreakLoss,
+			current:      2,
+			longest:      2,
+			longestStart: 1,
+		},
+		{
+			name:         "tie breaks both",
+			games:        played([]int{3, 0}, nil),
+			streakType:   StreakWin,
+			current:      0,
+			longest:      1,
+			longestStart: 0,
+		},
+		{
+			name:         "equal runs keep the earlier",
+			games:        played([]int{3, 3, -3, 3, 3}, nil),
+			streakType:   StreakWin,
+			current:      2,
+			longest:      2,
+			longestStart: 0,
+		},
+		{
+			name:         "push and missing line neither extend nor break",
+			games:        played([]int{3, 3, 3, 3}, []*float64{margin(2), margin(0), nil, margin(1.5)}),
+			streakType:   StreakATSCover,
+			current:      2,
+			longest:      2,
+			longestStart: 0,
+		},
+		{
+			name:         "missed spread",
+			games:        played([]int{3, 3, 3}, []*float64{margin(-1), margin(-2.5), margin(4)}),
+			streakType:   StreakATSMiss,
+			current:      0,
+			longest:      2,
+			longestStart: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			streak := streakOf(applyGames("BUF", nil, tt.games), tt.streakType)
+			if streak.Current != tt.current || streak.Longest != tt.longest {
+				t.Fatalf("current %d, longest %d; want %d and %d", streak.Current, streak.Longest, tt.current, tt.longest)
+			}
+			if tt.longest > 0 && !streak.LongestStartDate.Equal(tt.games[tt.longestStart].Date) {
+				t.Errorf("longest started %v, want %v", streak.LongestStartDate, tt.games[tt.longestStart].Date)
+			}
+			if len(tt.games) > 0 && streak.LastGameKey != tt.games[len(tt.games)-1].GameKey {
+				t.Errorf("last game %q, want %q", streak.LastGameKey, tt.games[len(tt.games)-1].GameKey)
+			}
+		})
+	}
+}
+
+func TestApplyGamesIncrementally(t *testing.T) {
+	games := played([]int{3, 7, -3, 10, 14, 21, -1, 6}, []*float64{margin(1), nil, margin(-2), margin(0), margin(3), margin(9), margin(-4), margin(2)})
+	full := applyGames("BUF", nil, games)
+
+	for split := 0; split <= len(games); split++ {
+		stored := map[string]*Streak{}
+		for _, streak := range applyGames("BUF", nil, games[:split]) {
+			streak := streak
+			stored[streak.Type] = &streak
+		}
+
+		incremental := applyGames("BUF", stored, games[split:])
+		for i := range full {
+			want, got := full[i], incremental[i]
+			got.UpdatedAt = want.UpdatedAt
+			if got != want {
+				t.Errorf("split at %d, %s: got %+v, want %+v", split, want.Type, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,384 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// Trend splits
+const (
+	SplitAll      = "all"
+	SplitHome     = "home"
+	SplitAway     = "away"
+	SplitFavorite = "favorite"
+	SplitUnderdog = "underdog"
+)
+
+// Leaderboard metrics
+const (
+	MetricATS         = "ats"
+	MetricCoverMargin = "cover_margin"
+	MetricOver        = "over"
+	MetricUnder       = "under"
+)
+
+// ATSRecord is a record against the spread. Games without a line are not counted.
+type ATSRecord struct {
+	Wins   int `json:"wins"`
+	Losses int `json:"losses"`
+	Pushes int `json:"pushes"`
+	// CoverPct is the share of covers among the games that weren't pushes
+	CoverPct float64 `json:"coverPct"`
+	// AvgCoverMargin is by how many points the team beat the spread on average; negative if it fell short
+	AvgCoverMargin float64 `json:"avgCoverMargin"`
+}
+
+// OverUnderRecord is a record against the over/under. Games without a total are not counted.
+type OverUnderRecord struct {
+	Overs  int `json:"overs"`
+	Unders int `json:"unders"`
+	Pushes int `json:"pushes"`
+	// OverPct is the share of overs among the games that weren't pushes
+	OverPct float64 `json:"overPct"`
+	// AvgTotalMargin is by how many points the combined score beat the total on average
+	AvgTotalMargin float64 `json:"avgTotalMargin"`
+}
+
+// TrendSplit is a team's results over a subset of its games
+type TrendSplit struct {
+	Games     int             `json:"games"`
+	Wins      int             `json:"wins"`
+	Losses    int             `json:"losses"`
+	Ties      int             `json:"ties"`
+	ATS       ATSRecord       `json:"ats"`
+	OverUnder OverUnderRecord `json:"overUnder"`
+}
+
+// TeamTrends is a team's results for a season, overall and split by venue and by whether it was favored.
+// Neutral venue games count in neither the home nor the away split, and pick'em games in neither the
+// favorite nor the underdog split.
+type TeamTrends struct {
+	Team       string     `json:"team"`
+	Season     int        `json:"season"`
+	SeasonType int        `json:"seasonType"`
+	Overall    TrendSplit `json:"overall"`
+	Home       TrendSplit `json:"home"`
+	Away       TrendSplit `json:"away"`
+	Favorite   TrendSplit `json:"favorite"`
+	Underdog   TrendSplit `json:"underdog"`
+}
+
+// LeaderboardEntry is a team's place on a trends leaderboard
+type LeaderboardEntry struct {
+	Rank   int        `json:"rank"`
+	Team   string     `json:"team"`
+	Value  float64    `json:"value"`
+	Trends TrendSplit `json:"trends"`
+}
+
+// IsSplit reports whether split names a trend split
+func IsSplit(split string) bool {
+	switch split {
+	case SplitAll, SplitHome, SplitAway, SplitFavorite, SplitUnderdog:
+		return true
+	}
+	return false
+}
+
+// IsMetric reports whether metric names a leaderboard metric
+func IsMetric(metric string) bool {
+	switch metric {
+	case MetricATS, MetricCoverMargin, MetricOver, MetricUnder:
+		return true
+	}
+	return false
+}
+
+// splitRow is one team and split as computed by the trends pipeline
+type splitRow struct {
+	ID struct {
+		Team  string `bson:"Team"`
+		Split string `bson:"Split"`
+	} `bson:"_id"`
+	Games          int      `bson:"Games"`
+	Wins           int      `bson:"Wins"`
+	Losses         int      `bson:"Losses"`
+	Ties           int      `bson:"Ties"`
+	ATSWins        int      `bson:"ATSWins"`
+	ATSLosses      int      `bson:"ATSLosses"`
+	ATSPushes      int      `bson:"ATSPushes"`
+	AvgCoverMargin *float64 `bson:"AvgCoverMargin"`
+	Overs          int      `bson:"Overs"`
+	Unders         int      `bson:"Unders"`
+	OUPushes       int      `bson:"OUPushes"`
+	AvgTotalMargin *float64 `bson:"AvgTotalMargin"`
+}
+
+// TeamTrends computes a team's trends for a season. A team without final games has empty trends.
+func (s *Service) TeamTrends(ctx context.Context, team string, season int, seasonType int) (*TeamTrends, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component":   "analytics.TeamTrends",
+		"team":        team,
+		"season":      season,
+		"season_type": seasonType,
+	})
+	log.Info("Computing team trends")
+
+	trends, err := s.trends(ctx, team, season, seasonType)
+	if err != nil {
+		log.WithError(err).Error("Failed to compute team trends")
+		return nil, err
+	}
+
+	if len(trends) == 0 {
+		log.Info("No final games")
+		return &TeamTrends{Team: team, Season: season, SeasonType: seasonType}, nil
+	}
+
+	log.WithField("games", trends[0].Overall.Games).Info("Team trends computed successfully")
+	return &trends[0], nil
+}
+
+// Leaderboard ranks every team by a metric over one split of its season's games. Teams without a counted
+// game in the split are left out. Ties keep the team with more counted games first.
+func (s *Service) Leaderboard(ctx context.Context, season int, seasonType int, metric string, split string, limit int) ([]LeaderboardEntry, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component":   "analytics.Leaderboard",
+		"season":      season,
+		"season_type": seasonType,
+		"metric":      metric,
+		"split":       split,
+	})
+	log.Info("Computing trends leaderboard")
+
+	if !IsMetric(metric) {
+		return nil, fmt.Errorf("unknown metric %q", metric)
+	}
+	if !IsSplit(split) {
+		return nil, fmt.Errorf("unknown split %q", split)
+	}
+
+	trends, err := s.trends(ctx, "", season, seasonType)
+	if err != nil {
+		log.WithError(err).Error("Failed to compute trends")
+		return nil, err
+	}
+
+	type ranked struct {
+		entry   LeaderboardEntry
+		counted int
+	}
+	rows := []ranked{}
+	for i := range trends {
+		trendSplit := trends[i].split(split)
+		value, counted := metricValue(trendSplit, metric)
+		if counted == 0 {
+			continue
+		}
+		rows = append(rows, ranked{
+			entry:   LeaderboardEntry{Team: trends[i].Team, Value: value, Trends: *trendSplit},
+			counted: counted,
+		})
+	}
+
+	sort.SliceStable(rows, func(a, b int) bool {
+		if rows[a].entry.Value != rows[b].entry.Value {
+			return rows[a].entry.Value > rows[b].entry.Value
+		}
+		if rows[a].counted != rows[b].counted {
+			return rows[a].counted > rows[b].counted
+		}
+		return rows[a].entry.Team < rows[b].entry.Team
+	})
+
+	entries := []LeaderboardEntry{}
+	for i, row := range rows {
+		if limit > 0 && i >= limit {
+			break
+		}
+		row.entry.Rank = i + 1
+		entries = append(entries, row.entry)
+	}
+
+	log.WithField("count", len(entries)).Info("Trends leaderboard computed successfully")
+	return entries, nil
+}
+
+// metricValue returns a split's value for a metric and the number of games it is computed over
+func metricValue(split *TrendSplit, metric string) (float64, int) {
+	switch metric {
+	case MetricATS:
+		return split.ATS.CoverPct, split.ATS.Wins + split.ATS.Losses
+	case MetricCoverMargin:
+		return split.ATS.AvgCoverMargin, split.ATS.Wins + split.ATS.Losses + split.ATS.Pushes
+	case MetricOver:
+		return split.OverUnder.OverPct, split.OverUnder.Overs + split.OverUnder.Unders
+	case MetricUnder:
+		decided := split.OverUnder.Overs + split.OverUnder.Unders
+		if decided == 0 {
+			return 0, 0
+		}
+		return round(1 - split.OverUnder.OverPct), decided
+	}
+	return 0, 0
+}
+
+func (t *TeamTrends) split(split string) *TrendSplit {
+	switch split {
+	case SplitHome:
+		return &t.Home
+	case SplitAway:
+		return &t.Away
+	case SplitFavorite:
+		return &t.Favorite
+	case SplitUnderdog:
+		return &t.Underdog
+	default:
+		return &t.Overall
+	}
+}
+
+// trends computes the trends of one team, or of every team when team is empty, ordered by team
+func (s *Service) trends(ctx context.Context, team string, season int, seasonType int) ([]TeamTrends, error) {
+	match := finalGames(season, seasonType)
+	if team != "" {
+		match["$or"] = []bson.M{{"HomeTeam": team}, {"AwayTeam": team}}
+	}
+
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: match}}}
+	pipeline = append(pipeline, teamSides()...)
+	if team != "" {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"Team": team}}})
+	}
+	pipeline = append(pipeline,
+		// Each game counts towards the overall split and, unless it was played at a neutral venue,
+		// its venue split, and unless it was a pick'em, the favorite or underdog split
+		bson.D{{Key: "$addFields", Value: bson.M{
+			"Splits": bson.M{"$concatArrays": bson.A{
+				bson.A{SplitAll},
+				bson.M{"$switch": bson.M{
+					"branches": bson.A{
+						bson.M{"case": bson.M{"$eq": bson.A{"$NeutralVenue", true}}, "then": bson.A{}},
+						bson.M{"case": "$Home", "then": bson.A{SplitHome}},
+					},
+					"default": bson.A{SplitAway},
+				}},
+				bson.M{"$switch": bson.M{
+					"branches": bson.A{
+						bson.M{"case": isBelow("$Spread", 0), "then": bson.A{SplitFavorite}},
+						bson.M{"case": isAbove("$Spread", 0), "then": bson.A{SplitUnderdog}},
+					},
+					"default": bson.A{},
+				}},
+			}},
+		}}},
+		bson.D{{Key: "$unwind", Value: "$Splits"}},
+		bson.D{{Key: "$group", Value: bson.M{
+			"_id":            bson.M{"Team": "$Team", "Split": "$Splits"},
+			"Games":          bson.M{"$sum": 1},
+			"Wins":           countIf(isAbove("$Margin", 0)),
+			"Losses":         countIf(isBelow("$Margin", 0)),
+			"Ties":           countIf(bson.M{"$eq": bson.A{"$Margin", 0}}),
+			"ATSWins":        countIf(isAbove("$CoverMargin", 0)),
+			"ATSLosses":      countIf(isBelow("$CoverMargin", 0)),
+			"ATSPushes":      countIf(bson.M{"$eq": bson.A{"$CoverMargin", 0}}),
+			"AvgCoverMargin": bson.M{"$avg": "$CoverMargin"},
+			"Overs":          countIf(isAbove("$TotalMargin", 0)),
+			"Unders":         countIf(isBelow("$TotalMargin", 0)),
+			"OUPushes":       countIf(bson.M{"$eq": bson.A{"$TotalMargin", 0}}),
+			"AvgTotalMargin": bson.M{"$avg": "$TotalMargin"},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "_id.Team", Value: 1}}}},
+	)
+
+	cursor, err := s.games.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []splitRow
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	trends := []TeamTrends{}
+	byTeam := map[string]int{}
+	for _, row := range rows {
+		i, ok := byTeam[row.ID.Team]
+		if !ok {
+			i = len(trends)
+			byTeam[row.ID.Team] = i
+			trends = append(trends, TeamTrends{Team: row.ID.Team, Season: season, SeasonType: seasonType})
+		}
+		*trends[i].split(row.ID.Split) = row.trendSplit()
+	}
+
+	return trends, nil
+}
+
+func (r *splitRow) trendSplit() TrendSplit {
+	split := TrendSplit{
+		Games:  r.Games,
+		Wins:   r.Wins,
+		Losses: r.Losses,
+		Ties:   r.Ties,
+		ATS: ATSRecord{
+			Wins:   r.ATSWins,
+			Losses: r.ATSLosses,
+			Pushes: r.ATSPushes,
+		},
+		OverUnder: OverUnderRecord{
+			Overs:  r.Overs,
+			Unders: r.Unders,
+			Pushes: r.OUPushes,
+		},
+	}
+	if decided := r.ATSWins + r.ATSLosses; decided > 0 {
+		split.ATS.CoverPct = round(float64(r.ATSWins) / float64(decided))
+	}
+	if r.AvgCoverMargin != nil {
+		split.ATS.AvgCoverMargin = round(*r.AvgCoverMargin)
+	}
+	if decided := r.Overs + r.Unders; decided > 0 {
+		split.OverUnder.OverPct = round(float64(r.Overs) / float64(decided))
+	}
+	if r.AvgTotalMargin != nil {
+		split.OverUnder.AvgTotalMargin = round(*r.AvgTotalMargin)
+	}
+	return split
+}
+
+// countIf sums the documents matching a condition
+func countIf(cond interface{}) bson.M {
+	return bson.M{"$sum": bson.M{"$cond": bson.A{cond, 1, 0}}}
+}
+
+// isAbove and isBelow compare a field that may be null. Null sorts below every number in MongoDB,
+// so a plain $lt would count it.
+func isAbove(field string, value float64) bson.M {
+	return bson.M{"$and": bson.A{
+		bson.M{"$ne": bson.A{field, nil}},
+		bson.M{"$gt": bson.A{field, value}},
+	}}
+}
+
+func isBelow(field string, value float64) bson.M {
+	return bson.M{"$and": bson.A{
+		bson.M{"$ne": bson.A{field, nil}},
+		bson.M{"$lt": bson.A{field, value}},
+	}}
+}
+
+// round keeps three decimals, enough for percentages and average margins
+func round(value float64) float64 {
+	return math.Round(value*1000) / 1000
+}
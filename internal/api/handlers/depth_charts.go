@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// GetTeamDepthChart handles the request to get a team's depth chart, either current or as of a past time
+func (h *Handler) GetTeamDepthChart(c *gin.Context) {
+	key := c.Param("key")
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetTeamDepthChart").WithField("team_key", key)
+	log.Info("GetTeamDepthChart requested")
+
+	q := newQueryParams(c)
+	asOf := q.timeParam("asOf")
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	if _, ok := h.teamByKey(c, log, key); !ok {
+		return
+	}
+
+	if asOf == nil {
+		charts, err := h.depthChartsRepo.FindByTeam(c.Request.Context(), key)
+		if err != nil {
+			log.WithError(err).Error("Failed to get depth chart")
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to get depth chart",
+			})
+			return
+		}
+
+		log.WithField("count", len(charts)).Info("Depth chart retrieved successfully")
+		c.JSON(http.StatusOK, gin.H{
+			"team":   key,
+			"charts": charts,
+		})
+		return
+	}
+
+	snapshots, err := h.depthChartsRepo.FindSnapshotsAsOf(c.Request.Context(), key, *asOf)
+	if err != nil {
+		log.WithError(err).Error("Failed to get depth chart snapshots")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get depth chart",
+		})
+		return
+	}
+
+	log.WithFields(logrus.Fields{
+		"as_of": asOf,
+		"count": len(snapshots),
+	}).Info("Depth chart snapshots retrieved successfully")
+	c.JSON(http.StatusOK, gin.H{
+		"team":   key,
+		"asOf":   asOf,
+		"charts": snapshots,
+	})
+}
+
+// GetDepthChartChanges handles the request to list depth chart promotions and demotions
+func (h *Handler) GetDepthChartChanges(c *gin.Context) {
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetDepthChartChanges")
+	log.Info("GetDepthChartChanges requested")
+
+	q := newQueryParams(c)
+	filter := &repositories.DepthChartChangeFilter{
+		Team:     strings.ToUpper(q.stringParam("team")),
+		Position: strings.ToUpper(q.stringParam("position")),
+		Types:    q.listParam("type"),
+		Since:    q.timeParam("since"),
+	}
+	for _, changeType := range filter.Types {
+		switch changeType {
+		case models.DepthChartPromoted, models.DepthChartDemoted, models.DepthChartAdded, models.DepthChartRemoved:
+		default:
+			q.fail("type", "must be promoted, demoted, added or removed")
+		}
+	}
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	opts, err := parseListOptions(c, models.DepthChartChange{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	page, err := h.depthChartsRepo.ListChanges(c.Request.Context(), filter, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to get depth chart changes")
+		respondListError(c, err, "Failed to get depth chart changes")
+		return
+	}
+
+	log.WithField("count", len(page.Items)).Info("Depth chart changes retrieved successfully")
+	respondPage(c, page, opts)
+}
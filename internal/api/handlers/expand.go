@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+)
+
+// Related documents that responses can embed with ?expand=
+const (
+	expandStadium = "stadium"
+	expandOdds    = "odds"
+)
+
+// teamResponse is a team with the related documents requested by ?expand=
+type teamResponse struct {
+	*models.Team
+	StadiumDetails *models.Stadium `json:"stadiumDetails,omitempty"`
+}
+
+// scheduleResponse is a schedule with the related documents requested by ?expand=
+type scheduleResponse struct {
+	*models.Schedule
+	StadiumDetails *models.Stadium `json:"stadiumDetails,omitempty"`
+}
+
+// gameResponse is a game with the related documents requested by ?expand=
+type gameResponse struct {
+	*models.Game
+	StadiumDetails *models.Stadium     `json:"stadiumDetails,omitempty"`
+	Odds           *models.OddsSummary `json:"odds,omitempty"`
+}
+
+func (h *Handler) expandTeams(ctx context.Context, teams []models.Team, expand map[string]bool) ([]teamResponse, error) {
+	responses := make([]teamResponse, len(teams))
+	for i := range teams {
+		responses[i].Team = &teams[i]
+	}
+	if !expand[expandStadium] {
+		return responses, nil
+	}
+
+	stadiums, err := stadiumsByID(ctx, h.stadiumsRepo, teams, func(team *models.Team) int { return team.StadiumID })
+	if err != nil {
+		return nil, err
+	}
+	for i := range responses {
+		responses[i].StadiumDetails = stadiums[teams[i].StadiumID]
+	}
+	return responses, nil
+}
+
+func (h *Handler) expandSchedules(ctx context.Context, schedules []models.Schedule, expand map[string]bool) ([]scheduleResponse, error) {
+	responses := make([]scheduleResponse, len(schedules))
+	for i := range schedules {
+		responses[i].Schedule = &schedules[i]
+	}
+	if !expand[expandStadium] {
+		return responses, nil
+	}
+
+	stadiums, err := stadiumsByID(ctx, h.stadiumsRepo, schedules, func(schedule *models.Schedule) int { return schedule.StadiumID })
+	if err != nil {
+		return nil, err
+	}
+	for i := range responses {
+		responses[i].StadiumDetails = stadiums[schedules[i].StadiumID]
+	}
+	return responses, nil
+}
+
+func (h *Handler) expandGames(ctx context.Context, games []models.Game, expand map[string]bool) ([]gameResponse, error) {
+	responses := make([]gameResponse, len(games))
+	for i := range games {
+		responses[i].Game = &games[i]
+	}
+
+	if expand[expandStadium] {
+		stadiums, err := stadiumsByID(ctx, h.stadiumsRepo, games, func(game *models.Game) int { return game.StadiumID })
+		if err != nil {
+			return nil, err
+		}
+		for i := range responses {
+			responses[i].StadiumDetails = stadiums[games[i].StadiumID]
+		}
+	}
+
+	if expand[expandOdds] && len(games) > 0 {
+		gameKeys := make([]string, 0, len(games))
+		for i := range games {
+			gameKeys = append(gameKeys, games[i].GameKey)
+		}
+		summaries, err := h.oddsRepo.FindSummariesByGameKeys(ctx, gameKeys, models.OddsSportsbookConsensus)
+		if err != nil {
+			return nil, err
+		}
+		byKey := make(map[string]*models.OddsSummary, len(summaries))
+		for i := range summaries {
+			byKey[summaries[i].GameKey] = &summaries[i]
+		}
+		for i := range responses {
+			responses[i].Odds = byKey[games[i].GameKey]
+		}
+	}
+
+	return responses, nil
+}
+
+// stadiumsByID loads the stadiums the items refer to in one query. Items without a stadium, or whose
+// stadium isn't stored, have no entry.
+func stadiumsByID[T any](ctx context.Context, repo *repositories.StadiumsRepository, items []T, stadiumID func(*T) int) (map[int]*models.Stadium, error) {
+	ids := []int{}
+	seen := map[int]bool{}
+	for i := range items {
+		if id := stadiumID(&items[i]); id != 0 && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	byID := make(map[int]*models.Stadium, len(ids))
+	if len(ids) == 0 {
+		return byID, nil
+	}
+
+	stadiums, err := repo.FindByStadiumIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range stadiums {
+		byID[stadiums[i].StadiumID] = &stadiums[i]
+	}
+	return byID, nil
+}
+
+// pageOf returns page with its items replaced by their responses
+func pageOf[T any, R any](page *repositories.Page[T], items []R) *repositories.Page[R] {
+	return &repositories.Page[R]{
+		Items:      items,
+		Total:      page.Total,
+		NextCursor: page.NextCursor,
+	}
+}
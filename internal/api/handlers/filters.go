@@ -0,0 +1,289 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+	"github.com/web-dev-jesus/trendzone/internal/sportsdata"
+)
+
+const queryDateLayout = "2006-01-02"
+
+// invalidParam describes a query parameter that failed validation
+type invalidParam struct {
+	Param   string `json:"param"`
+	Message string `json:"message"`
+}
+
+// queryParams parses typed query parameters, collecting every invalid one instead of stopping at the first
+type queryParams struct {
+	c       *gin.Context
+	invalid []invalidParam
+}
+
+func newQueryParams(c *gin.Context) *queryParams {
+	return &queryParams{c: c}
+}
+
+func (q *queryParams) fail(param string, format string, args ...interface{}) {
+	q.invalid = append(q.invalid, invalidParam{Param: param, Message: fmt.Sprintf(format, args...)})
+}
+
+func (q *queryParams) stringParam(param string) string {
+	return strings.TrimSpace(q.c.Query(param))
+}
+
+func (q *queryParams) intParam(param string) *int {
+	value := q.stringParam(param)
+	if value == "" {
+		return nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		q.fail(param, "must be an integer")
+		return nil
+	}
+	return &n
+}
+
+func (q *queryParams) floatParam(param string) *float64 {
+	value := q.stringParam(param)
+	if value == "" {
+		return nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		q.fail(param, "must be a number")
+		return nil
+	}
+	return &f
+}
+
+func (q *queryParams) boolParam(param string) bool {
+	value := q.stringParam(param)
+	if value == "" {
+		return false
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		q.fail(param, "must be true or false")
+		return false
+	}
+	return b
+}
+
+// optionalBoolParam is like boolParam but distinguishes an absent parameter from false
+func (q *queryParams) optionalBoolParam(param string) *bool {
+	if q.stringParam(param) == "" {
+		return nil
+	}
+	b := q.boolParam(param)
+	return &b
+}
+
+func (q *queryParams) dateParam(param string) *time.Time {
+	value := q.stringParam(param)
+	if value == "" {
+		return nil
+	}
+	t, err := time.Parse(queryDateLayout, value)
+	if err != nil {
+		q.fail(param, "must be a date in YYYY-MM-DD format")
+		return nil
+	}
+	return &t
+}
+
+// timeParam accepts an RFC 3339 timestamp or a YYYY-MM-DD date, which means midnight UTC
+func (q *queryParams) timeParam(param string) *time.Time {
+	value := q.stringParam(param)
+	if value == "" {
+		return nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t
+	}
+	t, err := time.Parse(queryDateLayout, value)
+	if err != nil {
+		q.fail(param, "must be an RFC 3339 timestamp or a date in YYYY-MM-DD format")
+		return nil
+	}
+	return &t
+}
+
+// list splits a comma-separated parameter, dropping empty entries
+func (q *queryParams) listParam(param string) []string {
+	var values []string
+	for _, value := range strings.Split(q.stringParam(param), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// weekRange reads weekFrom and weekTo, with week as shorthand for weekFrom=weekTo
+func (q *queryParams) weekRange() (*int, *int) {
+	from := q.intParam("weekFrom")
+	to := q.intParam("weekTo")
+
+	if week := q.intParam("week"); week != nil {
+		if from != nil || to != nil {
+			q.fail("week", "cannot be combined with weekFrom or weekTo")
+		}
+		from, to = week, week
+	}
+	if from != nil && to != nil && *from > *to {
+		q.fail("weekFrom", "must not be after weekTo")
+	}
+
+	return from, to
+}
+
+// maxSeasonRange bounds how many seasons a range in a seasons parameter may span
+const maxSeasonRange = 100
+
+// seasonsParam reads a comma-separated list of seasons and season ranges, such as 2019-2021,2023,
+// and returns the seasons in ascending order without duplicates
+func (q *queryParams) seasonsParam(param string) []int {
+	var seasons []int
+	for _, value := range q.listParam(param) {
+		from, to, isRange := strings.Cut(value, "-")
+		if !isRange {
+			to = from
+		}
+		first, errFrom := strconv.Atoi(strings.TrimSpace(from))
+		last, errTo := strconv.Atoi(strings.TrimSpace(to))
+		switch {
+		case errFrom != nil || errTo != nil || first < 1 || last < 1:
+			q.fail(param, "must be a comma-separated list of seasons or season ranges such as 2019-2023")
+			return nil
+		case first > last:
+			q.fail(param, "range %s must not end before it starts", value)
+			return nil
+		case last-first >= maxSeasonRange:
+			q.fail(param, "range %s must not span more than %d seasons", value, maxSeasonRange)
+			return nil
+		}
+		for season := first; season <= last; season++ {
+			seasons = append(seasons, season)
+		}
+	}
+
+	slices.Sort(seasons)
+	return slices.Compact(seasons)
+}
+
+// expandParam reads the comma-separated expand parameter, accepting only the given expansions
+func (q *queryParams) expandParam(supported ...string) map[string]bool {
+	expand := map[string]bool{}
+	for _, value := range q.listParam("expand") {
+		if !slices.Contains(supported, value) {
+			q.fail("expand", "must be one of %s", strings.Join(supported, ", "))
+			continue
+		}
+		expand[value] = true
+	}
+	return expand
+}
+
+// respondInvalid writes a 400 listing the invalid parameters and reports whether there were any
+func (q *queryParams) respondInvalid() bool {
+	if len(q.invalid) == 0 {
+		return false
+	}
+	q.c.JSON(http.StatusBadRequest, gin.H{
+		"error":         "Invalid query parameters",
+		"invalidParams": q.invalid,
+	})
+	return true
+}
+
+// seasonParams reads season, as a year or a season code such as 2023POST, and seasonType, which overrides the
+// code's season type. Without a season the configured SportsData.io season is used.
+func (h *Handler) seasonParams(q *queryParams) (int, int) {
+	param, code := "season", q.stringParam("season")
+	if code == "" {
+		param, code = "SPORTSDATA_SEASON", h.config.SportsData.Season
+	}
+
+	season, seasonType, err := sportsdata.ParseSeasonCode(code)
+	if err != nil {
+		q.fail(param, "must be a year or a season code such as 2023REG")
+	}
+	if override := q.intParam("seasonType"); override != nil {
+		if *override < 1 || *override > 3 {
+			q.fail("seasonType", "must be 1 (regular season), 2 (preseason) or 3 (postseason)")
+		}
+		seasonType = *override
+	}
+
+	return season, seasonType
+}
+
+// parseMatchFilter reads the filters shared by the games and schedules endpoints
+func parseMatchFilter(q *queryParams) repositories.MatchFilter {
+	filter := repositories.MatchFilter{
+		Season:       q.intParam("season"),
+		SeasonType:   q.intParam("seasonType"),
+		Team:         strings.ToUpper(q.stringParam("team")),
+		Side:         strings.ToLower(q.stringParam("side")),
+		Statuses:     q.listParam("status"),
+		DateFrom:     q.dateParam("dateFrom"),
+		StadiumID:    q.intParam("stadium"),
+		Channel:      q.stringParam("channel"),
+		SpreadMin:    q.floatParam("spreadMin"),
+		SpreadMax:    q.floatParam("spreadMax"),
+		OverUnderMin: q.floatParam("overUnderMin"),
+		OverUnderMax: q.floatParam("overUnderMax"),
+	}
+
+	filter.WeekFrom, filter.WeekTo = q.weekRange()
+
+	if filter.SeasonType != nil && (*filter.SeasonType < 1 || *filter.SeasonType > 3) {
+		q.fail("seasonType", "must be 1 (regular season), 2 (preseason) or 3 (postseason)")
+	}
+
+	switch filter.Side {
+	case "":
+	case repositories.SideHome, repositories.SideAway:
+		if filter.Team == "" {
+			q.fail("side", "requires team")
+		}
+	default:
+		q.fail("side", "must be home or away")
+	}
+
+	// dateTo is inclusive, the repository bound is exclusive
+	if dateTo := q.dateParam("dateTo"); dateTo != nil {
+		end := dateTo.AddDate(0, 0, 1)
+		filter.DateTo = &end
+		if filter.DateFrom != nil && filter.DateFrom.After(*dateTo) {
+			q.fail("dateFrom", "must not be after dateTo")
+		}
+	}
+
+	if filter.SpreadMin != nil && filter.SpreadMax != nil && *filter.SpreadMin > *filter.SpreadMax {
+		q.fail("spreadMin", "must not be greater than spreadMax")
+	}
+	if filter.OverUnderMin != nil && filter.OverUnderMax != nil && *filter.OverUnderMin > *filter.OverUnderMax {
+		q.fail("overUnderMin", "must not be greater than overUnderMax")
+	}
+
+	return filter
+}
+
+// upper uppercases codes such as positions so they match the stored values
+func upper(values []string) []string {
+	for i, value := range values {
+		values[i] = strings.ToUpper(value)
+	}
+	return values
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func queryParamsOf(query string) *queryParams {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/?"+query, nil)
+	return newQueryParams(c)
+}
+
+func TestSeasonsParam(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    []int
+		invalid bool
+	}{
+		{name: "missing"},
+		{name: "one season", value: "2023", want: []int{2023}},
+		{name: "list", value: "2023, 2021,2022", want: []int{2021, 2022, 2023}},
+		{name: "range", value: "2019-2021", want: []int{2019, 2020, 2021}},
+		{name: "ranges and seasons overlapping", value: "2022,2019-2021,2020-2022", want: []int{2019, 2020, 2021, 2022}},
+		{name: "not a season", value: "2023,last", invalid: true},
+		{name: "open range", value: "2019-", invalid: true},
+		{name: "backwards range", value: "2023-2019", invalid: true},
+		{name: "range too wide", value: "1900-2023", invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := queryParamsOf("seasons=" + url.QueryEscape(tt.value))
+			got := q.seasonsParam("seasons")
+			if invalid := len(q.invalid) > 0; invalid != tt.invalid {
+				t.Fatalf("invalid = %v (%v), want %v", invalid, q.invalid, tt.invalid)
+			}
+			if !tt.invalid && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,11 +2,12 @@ package handlers
 
 import (
 	"net/http"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
 	"github.com/web-dev-jesus/trendzone/internal/logger"
 )
 
@@ -15,62 +16,46 @@ func (h *Handler) GetGames(c *gin.Context) {
 	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetGames")
 	log.Info("GetGames requested")
 
-	// Check for filters
-	team := c.Query("team")
-	seasonStr := c.Query("season")
-	weekStr := c.Query("week")
-
-	var season, week int
-	var err error
-
-	if seasonStr != "" {
-		season, err = strconv.Atoi(seasonStr)
-		if err != nil {
-			log.WithError(err).Error("Invalid season format")
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error": "Invalid season format",
-			})
-			return
-		}
+	// Parse filters; all of them are combined
+	q := newQueryParams(c)
+	filter := &repositories.GameFilter{
+		MatchFilter: parseMatchFilter(q),
+		Overtime:    q.boolParam("overtime"),
 	}
-
-	if weekStr != "" {
-		week, err = strconv.Atoi(weekStr)
-		if err != nil {
-			log.WithError(err).Error("Invalid week format")
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error": "Invalid week format",
-			})
-			return
-		}
+	expand := q.expandParam(expandStadium, expandOdds)
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
 	}
 
-	var games interface{}
-
-	// Apply filters
-	if team != "" {
-		log.WithField("team", team).Info("Getting games by team")
-		games, err = h.gamesRepo.FindByTeam(c.Request.Context(), team)
-	} else if seasonStr != "" && weekStr != "" {
-		log.WithFields(logrus.Fields{
-			"season": season,
-			"week":   week,
-		}).Info("Getting games by week")
-		games, err = h.gamesRepo.FindByWeek(c.Request.Context(), season, week)
-	} else {
-		log.Info("Getting all games")
-		games, err = h.gamesRepo.FindAll(c.Request.Context())
+	opts, err := parseListOptions(c, models.Game{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
 	}
 
+	log.WithField("query", c.Request.URL.RawQuery).Info("Getting games")
+
+	page, err := h.gamesRepo.List(c.Request.Context(), filter, opts)
 	if err != nil {
 		log.WithError(err).Error("Failed to get games")
+		respondListError(c, err, "Failed to get games")
+		return
+	}
+
+	games, err := h.expandGames(c.Request.Context(), page.Items, expand)
+	if err != nil {
+		log.WithError(err).Error("Failed to expand games")
 		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to get games",
+			"error": "Failed to expand games",
 		})
 		return
 	}
 
-	c.JSON(http.StatusOK, games)
+	respondPage(c, pageOf(page, games), opts)
 }
 
 // GetGameByID handles the request to get a game by ID
@@ -79,6 +64,13 @@ func (h *Handler) GetGameByID(c *gin.Context) {
 	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetGameByID").WithField("game_id", id)
 	log.Info("GetGameByID requested")
 
+	q := newQueryParams(c)
+	expand := q.expandParam(expandStadium, expandOdds)
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
 	game, err := h.gamesRepo.FindByID(c.Request.Context(), id)
 	if err != nil {
 		log.WithError(err).Error("Failed to get game")
@@ -96,8 +88,17 @@ func (h *Handler) GetGameByID(c *gin.Context) {
 		return
 	}
 
+	games, err := h.expandGames(c.Request.Context(), []models.Game{*game}, expand)
+	if err != nil {
+		log.WithError(err).Error("Failed to expand games")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to expand games",
+		})
+		return
+	}
+
 	log.Info("Game retrieved successfully")
-	c.JSON(http.StatusOK, game)
+	c.JSON(http.StatusOK, games[0])
 }
 
 // GetGameByGameKey handles the request to get a game by GameKey
@@ -106,13 +107,40 @@ func (h *Handler) GetGameByGameKey(c *gin.Context) {
 	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetGameByGameKey").WithField("game_key", gameKey)
 	log.Info("GetGameByGameKey requested")
 
+	q := newQueryParams(c)
+	expand := q.expandParam(expandStadium, expandOdds)
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	game, ok := h.gameByKey(c, log, gameKey)
+	if !ok {
+		return
+	}
+
+	games, err := h.expandGames(c.Request.Context(), []models.Game{*game}, expand)
+	if err != nil {
+		log.WithError(err).Error("Failed to expand games")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to expand games",
+		})
+		return
+	}
+
+	log.Info("Game retrieved successfully")
+	c.JSON(http.StatusOK, games[0])
+}
+
+// gameByKey loads the game with the given key, writing a 404 or 500 response and returning false if it can't
+func (h *Handler) gameByKey(c *gin.Context, log *logrus.Entry, gameKey string) (*models.Game, bool) {
 	game, err := h.gamesRepo.FindByGameKey(c.Request.Context(), gameKey)
 	if err != nil {
 		log.WithError(err).Error("Failed to get game")
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to get game",
 		})
-		return
+		return nil, false
 	}
 
 	if game == nil {
@@ -120,9 +148,8 @@ func (h *Handler) GetGameByGameKey(c *gin.Context) {
 		c.JSON(http.StatusNotFound, gin.H{
 			"error": "Game not found",
 		})
-		return
+		return nil, false
 	}
 
-	log.Info("Game retrieved successfully")
-	c.JSON(http.StatusOK, game)
+	return game, true
 }
@@ -4,21 +4,39 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
 
 	"github.com/web-dev-jesus/trendzone/config"
+	"github.com/web-dev-jesus/trendzone/internal/analytics"
 	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+	"github.com/web-dev-jesus/trendzone/internal/events"
 	"github.com/web-dev-jesus/trendzone/internal/logger"
+	"github.com/web-dev-jesus/trendzone/internal/projections"
+	"github.com/web-dev-jesus/trendzone/internal/ratings"
 	"github.com/web-dev-jesus/trendzone/internal/sportsdata"
 )
 
 type Handler struct {
-	config            *config.Config
-	teamsRepo         *repositories.TeamsRepository
-	playersRepo       *repositories.PlayersRepository
-	gamesRepo         *repositories.GamesRepository
-	standingsRepo     *repositories.StandingsRepository
-	schedulesRepo     *repositories.SchedulesRepository
-	sportsDataService *sportsdata.Service
+	config              *config.Config
+	teamsRepo           *repositories.TeamsRepository
+	playersRepo         *repositories.PlayersRepository
+	gamesRepo           *repositories.GamesRepository
+	standingsRepo       *repositories.StandingsRepository
+	schedulesRepo       *repositories.SchedulesRepository
+	syncJobsRepo        *repositories.SyncJobsRepository
+	playerGameStatsRepo *repositories.PlayerGameStatsRepository
+	teamGameStatsRepo   *repositories.TeamGameStatsRepository
+	teamSeasonStatsRepo *repositories.TeamSeasonStatsRepository
+	injuriesRepo        *repositories.InjuriesRepository
+	depthChartsRepo     *repositories.DepthChartsRepository
+	playByPlayRepo      *repositories.PlayByPlayRepository
+	stadiumsRepo        *repositories.StadiumsRepository
+	oddsRepo            *repositories.OddsRepository
+	sportsDataService   *sportsdata.Service
+	analyticsService    *analytics.Service
+	projectionsService  *projections.Service
+	ratingsService      *ratings.Service
+	broker              *events.Broker
 }
 
 func NewHandler(
@@ -28,16 +46,42 @@ func NewHandler(
 	gamesRepo *repositories.GamesRepository,
 	standingsRepo *repositories.StandingsRepository,
 	schedulesRepo *repositories.SchedulesRepository,
+	syncJobsRepo *repositories.SyncJobsRepository,
+	playerGameStatsRepo *repositories.PlayerGameStatsRepository,
+	teamGameStatsRepo *repositories.TeamGameStatsRepository,
+	teamSeasonStatsRepo *repositories.TeamSeasonStatsRepository,
+	injuriesRepo *repositories.InjuriesRepository,
+	depthChartsRepo *repositories.DepthChartsRepository,
+	playByPlayRepo *repositories.PlayByPlayRepository,
+	stadiumsRepo *repositories.StadiumsRepository,
+	oddsRepo *repositories.OddsRepository,
 	sportsDataService *sportsdata.Service,
+	analyticsService *analytics.Service,
+	projectionsService *projections.Service,
+	ratingsService *ratings.Service,
+	broker *events.Broker,
 ) *Handler {
 	return &Handler{
-		config:            config,
-		teamsRepo:         teamsRepo,
-		playersRepo:       playersRepo,
-		gamesRepo:         gamesRepo,
-		standingsRepo:     standingsRepo,
-		schedulesRepo:     schedulesRepo,
-		sportsDataService: sportsDataService,
+		config:              config,
+		teamsRepo:           teamsRepo,
+		playersRepo:         playersRepo,
+		gamesRepo:           gamesRepo,
+		standingsRepo:       standingsRepo,
+		schedulesRepo:       schedulesRepo,
+		syncJobsRepo:        syncJobsRepo,
+		playerGameStatsRepo: playerGameStatsRepo,
+		teamGameStatsRepo:   teamGameStatsRepo,
+		teamSeasonStatsRepo: teamSeasonStatsRepo,
+		injuriesRepo:        injuriesRepo,
+		depthChartsRepo:     depthChartsRepo,
+		playByPlayRepo:      playByPlayRepo,
+		stadiumsRepo:        stadiumsRepo,
+		oddsRepo:            oddsRepo,
+		sportsDataService:   sportsDataService,
+		analyticsService:    analyticsService,
+		projectionsService:  projectionsService,
+		ratingsService:      ratingsService,
+		broker:              broker,
 	}
 }
 
@@ -56,21 +100,59 @@ func (h *Handler) HealthCheck(c *gin.Context) {
 func (h *Handler) SyncData(c *gin.Context) {
 	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.SyncData")
 
-	// Extract season from query parameters, default to current year
-	season := c.DefaultQuery("season", "2023")
+	// Extract season from query parameters, default to the configured season
+	season := c.DefaultQuery("season", h.config.SportsData.Season)
 
-	log.WithField("season", season).Info("Data sync requested")
+	q := newQueryParams(c)
+	// force=true syncs payloads even if they haven't changed since the last sync
+	force := q.boolParam("force")
+	// entity limits the sync to some entities, week selects the week of weekly entities
+	entities := q.listParam("entity")
+	week := q.intParam("week")
 
-	// Start the sync process asynchronously
-	go func() {
-		ctx := c.Request.Context()
-		if err := h.sportsDataService.SyncAll(ctx, season); err != nil {
-			log.WithError(err).Error("Failed to sync data")
+	needsWeek := false
+	for _, entity := range entities {
+		if !sportsdata.IsSyncEntity(entity) {
+			q.fail("entity", "unknown entity %q", entity)
 		}
-	}()
+		needsWeek = needsWeek || sportsdata.IsWeeklyEntity(entity)
+	}
+	switch {
+	case week != nil && *week < 1:
+		q.fail("week", "must be a positive integer")
+	case week == nil && needsWeek:
+		q.fail("week", "is required to sync weekly entities")
+	}
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	weekNumber := 0
+	if week != nil {
+		weekNumber = *week
+	}
+
+	log.WithFields(logrus.Fields{
+		"season":   season,
+		"week":     weekNumber,
+		"entities": entities,
+		"force":    force,
+	}).Info("Data sync requested")
+
+	// Record the job and start the sync process asynchronously
+	job, err := h.sportsDataService.StartSyncJob(c.Request.Context(), season, weekNumber, force, entities...)
+	if err != nil {
+		log.WithError(err).Error("Failed to start sync job")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to start data synchronization",
+		})
+		return
+	}
 
 	c.JSON(http.StatusAccepted, gin.H{
 		"message": "Data synchronization started",
 		"season":  season,
+		"jobID":   job.JobID,
 	})
 }
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// GetInjuries handles the request to get the injury designations of all players
+func (h *Handler) GetInjuries(c *gin.Context) {
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetInjuries")
+	log.Info("GetInjuries requested")
+
+	q := newQueryParams(c)
+	filter := parseInjuryFilter(q)
+	filter.Team = strings.ToUpper(q.stringParam("team"))
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	opts, err := parseListOptions(c, models.Injury{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	page, err := h.injuriesRepo.List(c.Request.Context(), filter, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to get injuries")
+		respondListError(c, err, "Failed to get injuries")
+		return
+	}
+
+	log.WithField("count", len(page.Items)).Info("Injuries retrieved successfully")
+	respondPage(c, page, opts)
+}
+
+// GetPlayerInjuries handles the request to get a player's injury history
+func (h *Handler) GetPlayerInjuries(c *gin.Context) {
+	playerIDStr := c.Param("playerID")
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetPlayerInjuries").WithField("player_id", playerIDStr)
+	log.Info("GetPlayerInjuries requested")
+
+	playerID, err := strconv.Atoi(playerIDStr)
+	if err != nil {
+		log.WithError(err).Error("Invalid player ID format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid player ID format",
+		})
+		return
+	}
+
+	q := newQueryParams(c)
+	filter := parseInjuryFilter(q)
+	filter.PlayerID = &playerID
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	opts, err := parseListOptions(c, models.Injury{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	player, err := h.playersRepo.FindByPlayerID(c.Request.Context(), playerID)
+	if err != nil {
+		log.WithError(err).Error("Failed to get player")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get player",
+		})
+		return
+	}
+
+	if player == nil {
+		log.Info("Player not found")
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+		return
+	}
+
+	page, err := h.injuriesRepo.List(c.Request.Context(), filter, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to get player injuries")
+		respondListError(c, err, "Failed to get player injuries")
+		return
+	}
+
+	log.WithField("count", len(page.Items)).Info("Player injuries retrieved successfully")
+	respondPage(c, page, opts)
+}
+
+// parseInjuryFilter reads the filters shared by the injury endpoints
+func parseInjuryFilter(q *queryParams) *repositories.InjuryFilter {
+	filter := &repositories.InjuryFilter{
+		Season:     q.intParam("season"),
+		SeasonType: q.intParam("seasonType"),
+		Statuses:   q.listParam("status"),
+	}
+	filter.WeekFrom, filter.WeekTo = q.weekRange()
+	return filter
+}
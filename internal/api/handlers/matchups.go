@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/analytics"
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+	"github.com/web-dev-jesus/trendzone/internal/sportsdata"
+)
+
+// recentSeasons is how many seasons, up to the latest requested one, count as recent in a matchup
+const recentSeasons = 5
+
+// matchupResponse is a head-to-head summary with the meetings it was computed from and the next one
+type matchupResponse struct {
+	*analytics.Matchup
+	Games       []gameResponse    `json:"games"`
+	NextMeeting *scheduleResponse `json:"nextMeeting"`
+}
+
+// GetMatchup handles the request to get the head-to-head history of two teams
+func (h *Handler) GetMatchup(c *gin.Context) {
+	teamA := strings.ToUpper(c.Param("teamA"))
+	teamB := strings.ToUpper(c.Param("teamB"))
+	log := logger.WithRequestContext(c.Request.Context()).WithFields(logrus.Fields{
+		"component": "handlers.GetMatchup",
+		"team_a":    teamA,
+		"team_b":    teamB,
+	})
+	log.Info("GetMatchup requested")
+
+	if teamA == teamB {
+		log.Error("Matchup of a team against itself")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "A matchup needs two different teams",
+		})
+		return
+	}
+
+	q := newQueryParams(c)
+	seasons := q.seasonsParam("seasons")
+	// Recent meetings count back from the latest requested season, or from the current one
+	var latest int
+	if len(seasons) > 0 {
+		latest = seasons[len(seasons)-1]
+	} else if season, _, err := sportsdata.ParseSeasonCode(h.config.SportsData.Season); err != nil {
+		q.fail("SPORTSDATA_SEASON", "must be a year or a season code such as 2023REG")
+	} else {
+		latest = season
+	}
+	expand := q.expandParam(expandStadium, expandOdds)
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	if _, ok := h.teamByKey(c, log, teamA); !ok {
+		return
+	}
+	if _, ok := h.teamByKey(c, log, teamB); !ok {
+		return
+	}
+
+	meetings, err := h.gamesRepo.FindMeetings(c.Request.Context(), teamA, teamB, seasons)
+	if err != nil {
+		log.WithError(err).Error("Failed to get games between teams")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get games between teams",
+		})
+		return
+	}
+
+	next, err := h.schedulesRepo.FindNextMeeting(c.Request.Context(), teamA, teamB, time.Now())
+	if err != nil {
+		log.WithError(err).Error("Failed to get next scheduled game")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get next scheduled game",
+		})
+		return
+	}
+
+	games, err := h.expandGames(c.Request.Context(), meetings, expand)
+	if err != nil {
+		log.WithError(err).Error("Failed to expand games")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to expand games",
+		})
+		return
+	}
+
+	response := matchupResponse{
+		Matchup: analytics.SummarizeMatchup(teamA, teamB, meetings, latest-recentSeasons+1, latest),
+		Games:   games,
+	}
+	if next != nil {
+		schedules, err := h.expandSchedules(c.Request.Context(), []models.Schedule{*next}, expand)
+		if err != nil {
+			log.WithError(err).Error("Failed to expand schedules")
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to expand schedules",
+			})
+			return
+		}
+		response.NextMeeting = &schedules[0]
+	}
+
+	log.WithField("count", len(meetings)).Info("Matchup retrieved successfully")
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// GetGameOddsHistory handles the request to get the line movement of a game, one snapshot per move
+func (h *Handler) GetGameOddsHistory(c *gin.Context) {
+	gameKey := c.Param("gameKey")
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetGameOddsHistory").WithField("game_key", gameKey)
+	log.Info("GetGameOddsHistory requested")
+
+	q := newQueryParams(c)
+	filter := &repositories.OddsSnapshotFilter{
+		GameKey:     gameKey,
+		Sportsbooks: q.listParam("sportsbook"),
+	}
+
+	opts, err := parseListOptions(c, models.OddsSnapshot{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, ok := h.gameByKey(c, log, gameKey); !ok {
+		return
+	}
+
+	page, err := h.oddsRepo.List(c.Request.Context(), filter, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to get odds history")
+		respondListError(c, err, "Failed to get odds history")
+		return
+	}
+
+	log.WithField("count", len(page.Items)).Info("Odds history retrieved successfully")
+	respondPage(c, page, opts)
+}
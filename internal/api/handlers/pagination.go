@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+)
+
+const (
+	defaultPageLimit = 100
+	maxPageLimit     = 1000
+)
+
+// listResponse is the envelope returned by every list endpoint
+type listResponse struct {
+	Items      interface{} `json:"items"`
+	Total      int64       `json:"total"`
+	Limit      int64       `json:"limit"`
+	Offset     int64       `json:"offset"`
+	NextCursor string      `json:"nextCursor,omitempty"`
+	Next       string      `json:"next,omitempty"`
+}
+
+// parseListOptions reads limit, offset, cursor and sort from the query string.
+// sort is a comma-separated list of the model's JSON field names, each optionally prefixed with "-" for descending order.
+func parseListOptions(c *gin.Context, model interface{}) (*repositories.ListOptions, error) {
+	opts := &repositories.ListOptions{
+		Limit:  defaultPageLimit,
+		Cursor: c.Query("cursor"),
+	}
+
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err := strconv.ParseInt(limitStr, 10, 64)
+		if err != nil || limit < 1 || limit > maxPageLimit {
+			return nil, fmt.Errorf("Invalid limit format, must be between 1 and %d", maxPageLimit)
+		}
+		opts.Limit = limit
+	}
+
+	if offsetStr := c.Query("offset"); offsetStr != "" {
+		offset, err := strconv.ParseInt(offsetStr, 10, 64)
+		if err != nil || offset < 0 {
+			return nil, errors.New("Invalid offset format, must be a non-negative integer")
+		}
+		opts.Offset = offset
+	}
+
+	if sortStr := c.Query("sort"); sortStr != "" {
+		for _, field := range strings.Split(sortStr, ",") {
+			direction := 1
+			if strings.HasPrefix(field, "-") {
+				direction = -1
+				field = field[1:]
+			}
+			bsonName, ok := repositories.FieldName(model, field)
+			if !ok {
+				return nil, fmt.Errorf("Invalid sort field %q", field)
+			}
+			opts.Sort = append(opts.Sort, bson.E{Key: bsonName, Value: direction})
+		}
+	}
+
+	return opts, nil
+}
+
+// respondPage writes a page in the list envelope, with a link to the next page if there is one
+func respondPage[T any](c *gin.Context, page *repositories.Page[T], opts *repositories.ListOptions) {
+	response := listResponse{
+		Items:      page.Items,
+		Total:      page.Total,
+		Limit:      opts.Limit,
+		Offset:     opts.Offset,
+		NextCursor: page.NextCursor,
+	}
+
+	if page.NextCursor != "" {
+		query := c.Request.URL.Query()
+		query.Set("cursor", page.NextCursor)
+		query.Del("offset")
+		response.Next = c.Request.URL.Path + "?" + query.Encode()
+	} else if next := opts.Offset + int64(len(page.Items)); len(page.Items) > 0 && next < page.Total {
+		// Pages without a cursor, such as relevance-ordered search results, continue by offset
+		query := c.Request.URL.Query()
+		query.Set("offset", strconv.FormatInt(next, 10))
+		response.Next = c.Request.URL.Path + "?" + query.Encode()
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// respondListError writes the error response for a failed List call
+func respondListError(c *gin.Context, err error, message string) {
+	if errors.Is(err, repositories.ErrInvalidCursor) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid cursor",
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"error": message,
+	})
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// GetGamePlays handles the request to get the play-by-play of a game
+func (h *Handler) GetGamePlays(c *gin.Context) {
+	gameKey := c.Param("gameKey")
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetGamePlays").WithField("game_key", gameKey)
+	log.Info("GetGamePlays requested")
+
+	q := newQueryParams(c)
+	filter := &repositories.PlayFilter{
+		GameKey:  gameKey,
+		Quarters: upper(q.listParam("quarter")),
+		Team:     strings.ToUpper(q.stringParam("team")),
+		Types:    q.listParam("type"),
+	}
+
+	opts, err := parseListOptions(c, models.Play{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, ok := h.gameByKey(c, log, gameKey); !ok {
+		return
+	}
+
+	page, err := h.playByPlayRepo.ListPlays(c.Request.Context(), filter, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to get plays")
+		respondListError(c, err, "Failed to get plays")
+		return
+	}
+
+	log.WithField("count", len(page.Items)).Info("Plays retrieved successfully")
+	respondPage(c, page, opts)
+}
+
+// GetGameDrives handles the request to get the drive summaries of a game
+func (h *Handler) GetGameDrives(c *gin.Context) {
+	gameKey := c.Param("gameKey")
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetGameDrives").WithField("game_key", gameKey)
+	log.Info("GetGameDrives requested")
+
+	q := newQueryParams(c)
+	filter := &repositories.DriveFilter{
+		GameKey:  gameKey,
+		Quarters: upper(q.listParam("quarter")),
+		Team:     strings.ToUpper(q.stringParam("team")),
+		Results:  q.listParam("result"),
+	}
+
+	opts, err := parseListOptions(c, models.Drive{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, ok := h.gameByKey(c, log, gameKey); !ok {
+		return
+	}
+
+	page, err := h.playByPlayRepo.ListDrives(c.Request.Context(), filter, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to get drives")
+		respondListError(c, err, "Failed to get drives")
+		return
+	}
+
+	log.WithField("count", len(page.Items)).Info("Drives retrieved successfully")
+	respondPage(c, page, opts)
+}
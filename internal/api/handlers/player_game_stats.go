@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// GetPlayerGameStats handles the request to get a player's box score stats game by game
+func (h *Handler) GetPlayerGameStats(c *gin.Context) {
+	playerIDStr := c.Param("playerID")
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetPlayerGameStats").WithField("player_id", playerIDStr)
+	log.Info("GetPlayerGameStats requested")
+
+	playerID, err := strconv.Atoi(playerIDStr)
+	if err != nil {
+		log.WithError(err).Error("Invalid player ID format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid player ID format",
+		})
+		return
+	}
+
+	q := newQueryParams(c)
+	filter := &repositories.PlayerGameStatsFilter{
+		PlayerID:   &playerID,
+		Season:     q.intParam("season"),
+		SeasonType: q.intParam("seasonType"),
+	}
+	filter.WeekFrom, filter.WeekTo = q.weekRange()
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	opts, err := parseListOptions(c, models.PlayerGameStats{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	player, err := h.playersRepo.FindByPlayerID(c.Request.Context(), playerID)
+	if err != nil {
+		log.WithError(err).Error("Failed to get player")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get player",
+		})
+		return
+	}
+
+	if player == nil {
+		log.Info("Player not found")
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Player not found",
+		})
+		return
+	}
+
+	page, err := h.playerGameStatsRepo.List(c.Request.Context(), filter, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to get player game stats")
+		respondListError(c, err, "Failed to get player game stats")
+		return
+	}
+
+	log.WithField("count", len(page.Items)).Info("Player game stats retrieved successfully")
+	respondPage(c, page, opts)
+}
+
+// GetGamePlayerStats handles the request to get the box score stats of every player in a game
+func (h *Handler) GetGamePlayerStats(c *gin.Context) {
+	gameKey := c.Param("gameKey")
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetGamePlayerStats").WithField("game_key", gameKey)
+	log.Info("GetGamePlayerStats requested")
+
+	q := newQueryParams(c)
+	filter := &repositories.PlayerGameStatsFilter{
+		GameKey:   gameKey,
+		Team:      strings.ToUpper(q.stringParam("team")),
+		Positions: upper(q.listParam("position")),
+	}
+
+	opts, err := parseListOptions(c, models.PlayerGameStats{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, ok := h.gameByKey(c, log, gameKey); !ok {
+		return
+	}
+
+	page, err := h.playerGameStatsRepo.List(c.Request.Context(), filter, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to get game player stats")
+		respondListError(c, err, "Failed to get game player stats")
+		return
+	}
+
+	log.WithField("count", len(page.Items)).Info("Game player stats retrieved successfully")
+	respondPage(c, page, opts)
+}
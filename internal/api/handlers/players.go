@@ -3,10 +3,12 @@ package handlers
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
 	"github.com/web-dev-jesus/trendzone/internal/logger"
 )
 
@@ -18,27 +20,85 @@ func (h *Handler) GetPlayers(c *gin.Context) {
 	// Check if team filter is provided
 	team := c.Query("team")
 
-	var players []models.Player
-	var err error
+	opts, err := parseListOptions(c, models.Player{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
 
 	if team != "" {
 		log.WithField("team", team).Info("Getting players by team")
-		players, err = h.playersRepo.FindByTeam(c.Request.Context(), team)
 	} else {
 		log.Info("Getting all players")
-		players, err = h.playersRepo.FindAll(c.Request.Context())
 	}
 
+	page, err := h.playersRepo.List(c.Request.Context(), &repositories.PlayerFilter{Team: team}, opts)
 	if err != nil {
 		log.WithError(err).Error("Failed to get players")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to get players",
+		respondListError(c, err, "Failed to get players")
+		return
+	}
+
+	log.WithField("count", len(page.Items)).Info("Players retrieved successfully")
+	respondPage(c, page, opts)
+}
+
+// SearchPlayers handles the request to search players by name and attributes
+func (h *Handler) SearchPlayers(c *gin.Context) {
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.SearchPlayers")
+	log.Info("SearchPlayers requested")
+
+	q := newQueryParams(c)
+	search := &repositories.PlayerSearch{
+		Query:              q.stringParam("q"),
+		Team:               strings.ToUpper(q.stringParam("team")),
+		Positions:          upper(q.listParam("position")),
+		PositionCategories: upper(q.listParam("positionCategory")),
+		FantasyPositions:   upper(q.listParam("fantasyPosition")),
+		Status:             q.stringParam("status"),
+		Active:             q.optionalBoolParam("active"),
+		College:            q.stringParam("college"),
+		ExperienceMin:      q.intParam("experienceMin"),
+		ExperienceMax:      q.intParam("experienceMax"),
+		AgeMin:             q.intParam("ageMin"),
+		AgeMax:             q.intParam("ageMax"),
+	}
+	if search.ExperienceMin != nil && search.ExperienceMax != nil && *search.ExperienceMin > *search.ExperienceMax {
+		q.fail("experienceMin", "must not be greater than experienceMax")
+	}
+	if search.AgeMin != nil && search.AgeMax != nil && *search.AgeMin > *search.AgeMax {
+		q.fail("ageMin", "must not be greater than ageMax")
+	}
+	// Relevance-ordered results are paged by offset only
+	if search.Query != "" && c.Query("cursor") != "" {
+		q.fail("cursor", "cannot be combined with q, use offset instead")
+	}
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	opts, err := parseListOptions(c, models.Player{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
 		})
 		return
 	}
 
-	log.WithField("count", len(players)).Info("Players retrieved successfully")
-	c.JSON(http.StatusOK, players)
+	page, err := h.playersRepo.Search(c.Request.Context(), search, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to search players")
+		respondListError(c, err, "Failed to search players")
+		return
+	}
+
+	log.WithField("count", len(page.Items)).Info("Players search completed")
+	respondPage(c, page, opts)
 }
 
 // GetPlayerByID handles the request to get a player by ID
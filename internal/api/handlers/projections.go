@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+const (
+	defaultProjectionIterations = 1000
+	maxProjectionIterations     = 20000
+	// defaultProjectionSeed makes repeated requests without a seed return the same, cached projection
+	defaultProjectionSeed = 1
+)
+
+// GetPlayoffProjections handles the request to simulate the rest of a season and report playoff odds
+func (h *Handler) GetPlayoffProjections(c *gin.Context) {
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetPlayoffProjections")
+	log.Info("GetPlayoffProjections requested")
+
+	q := newQueryParams(c)
+	season, _ := h.seasonParams(q)
+	iterations := defaultProjectionIterations
+	if n := q.intParam("iterations"); n != nil {
+		if *n < 1 || *n > maxProjectionIterations {
+			q.fail("iterations", "must be between 1 and %d", maxProjectionIterations)
+		}
+		iterations = *n
+	}
+	seed := int64(defaultProjectionSeed)
+	if s := q.intParam("seed"); s != nil {
+		seed = int64(*s)
+	}
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	projection, err := h.projectionsService.PlayoffOdds(c.Request.Context(), season, iterations, seed)
+	if err != nil {
+		log.WithError(err).Error("Failed to project playoff odds")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to project playoff odds",
+		})
+		return
+	}
+
+	log.WithFields(logrus.Fields{
+		"season":     season,
+		"iterations": iterations,
+	}).Info("Playoff projections retrieved successfully")
+	c.JSON(http.StatusOK, projection)
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// GetRatings handles the request to get every team's Elo rating as of a week
+func (h *Handler) GetRatings(c *gin.Context) {
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetRatings")
+	log.Info("GetRatings requested")
+
+	q := newQueryParams(c)
+	season, seasonType := h.seasonParams(q)
+	week := q.intParam("week")
+	if week != nil && *week < 0 {
+		q.fail("week", "must not be negative")
+	}
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	ratings, err := h.ratingsService.Ratings(c.Request.Context(), season, seasonType, week)
+	if err != nil {
+		log.WithError(err).Error("Failed to get ratings")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get ratings",
+		})
+		return
+	}
+
+	log.WithFields(logrus.Fields{
+		"season": season,
+		"count":  len(ratings),
+	}).Info("Ratings retrieved successfully")
+	c.JSON(http.StatusOK, gin.H{
+		"season":     season,
+		"seasonType": seasonType,
+		"week":       week,
+		"teams":      ratings,
+	})
+}
+
+// GetTeamRatingHistory handles the request to get a team's weekly Elo ratings
+func (h *Handler) GetTeamRatingHistory(c *gin.Context) {
+	key := strings.ToUpper(c.Param("key"))
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetTeamRatingHistory").WithField("team_key", key)
+	log.Info("GetTeamRatingHistory requested")
+
+	// The whole history is returned unless a season is asked for
+	q := newQueryParams(c)
+	var season *int
+	if q.stringParam("season") != "" {
+		s, _ := h.seasonParams(q)
+		season = &s
+	}
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	if _, ok := h.teamByKey(c, log, key); !ok {
+		return
+	}
+
+	history, err := h.ratingsService.TeamHistory(c.Request.Context(), key, season)
+	if err != nil {
+		log.WithError(err).Error("Failed to get rating history")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get rating history",
+		})
+		return
+	}
+
+	log.WithField("count", len(history)).Info("Rating history retrieved successfully")
+	c.JSON(http.StatusOK, gin.H{
+		"team":    key,
+		"history": history,
+	})
+}
+
+// RecomputeRatings handles the request to rebuild the rating history from every stored game
+func (h *Handler) RecomputeRatings(c *gin.Context) {
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.RecomputeRatings")
+	log.Info("RecomputeRatings requested")
+
+	count, err := h.ratingsService.Recompute(c.Request.Context())
+	if err != nil {
+		log.WithError(err).Error("Failed to recompute ratings")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to recompute ratings",
+		})
+		return
+	}
+
+	log.WithField("ratings", count).Info("Ratings recomputed successfully")
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Ratings recomputed",
+		"ratings": count,
+	})
+}
@@ -2,11 +2,11 @@ package handlers
 
 import (
 	"net/http"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"github.com/sirupsen/logrus"
 
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
 	"github.com/web-dev-jesus/trendzone/internal/logger"
 )
 
@@ -15,62 +15,45 @@ func (h *Handler) GetSchedules(c *gin.Context) {
 	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetSchedules")
 	log.Info("GetSchedules requested")
 
-	// Check for filters
-	team := c.Query("team")
-	seasonStr := c.Query("season")
-	weekStr := c.Query("week")
-
-	var season, week int
-	var err error
-
-	if seasonStr != "" {
-		season, err = strconv.Atoi(seasonStr)
-		if err != nil {
-			log.WithError(err).Error("Invalid season format")
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error": "Invalid season format",
-			})
-			return
-		}
+	// Parse filters; all of them are combined
+	q := newQueryParams(c)
+	filter := &repositories.ScheduleFilter{
+		MatchFilter: parseMatchFilter(q),
 	}
-
-	if weekStr != "" {
-		week, err = strconv.Atoi(weekStr)
-		if err != nil {
-			log.WithError(err).Error("Invalid week format")
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error": "Invalid week format",
-			})
-			return
-		}
+	expand := q.expandParam(expandStadium)
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
 	}
 
-	var schedules interface{}
-
-	// Apply filters
-	if team != "" {
-		log.WithField("team", team).Info("Getting schedules by team")
-		schedules, err = h.schedulesRepo.FindByTeam(c.Request.Context(), team)
-	} else if seasonStr != "" && weekStr != "" {
-		log.WithFields(logrus.Fields{
-			"season": season,
-			"week":   week,
-		}).Info("Getting schedules by week")
-		schedules, err = h.schedulesRepo.FindByWeek(c.Request.Context(), season, week)
-	} else {
-		log.Info("Getting all schedules")
-		schedules, err = h.schedulesRepo.FindAll(c.Request.Context())
+	opts, err := parseListOptions(c, models.Schedule{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
 	}
 
+	log.WithField("query", c.Request.URL.RawQuery).Info("Getting schedules")
+
+	page, err := h.schedulesRepo.List(c.Request.Context(), filter, opts)
 	if err != nil {
 		log.WithError(err).Error("Failed to get schedules")
+		respondListError(c, err, "Failed to get schedules")
+		return
+	}
+
+	schedules, err := h.expandSchedules(c.Request.Context(), page.Items, expand)
+	if err != nil {
+		log.WithError(err).Error("Failed to expand schedules")
 		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to get schedules",
+			"error": "Failed to expand schedules",
 		})
 		return
 	}
 
-	c.JSON(http.StatusOK, schedules)
+	respondPage(c, pageOf(page, schedules), opts)
 }
 
 // GetScheduleByID handles the request to get a schedule by ID
@@ -79,6 +62,13 @@ func (h *Handler) GetScheduleByID(c *gin.Context) {
 	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetScheduleByID").WithField("schedule_id", id)
 	log.Info("GetScheduleByID requested")
 
+	q := newQueryParams(c)
+	expand := q.expandParam(expandStadium)
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
 	schedule, err := h.schedulesRepo.FindByID(c.Request.Context(), id)
 	if err != nil {
 		log.WithError(err).Error("Failed to get schedule")
@@ -96,8 +86,17 @@ func (h *Handler) GetScheduleByID(c *gin.Context) {
 		return
 	}
 
+	schedules, err := h.expandSchedules(c.Request.Context(), []models.Schedule{*schedule}, expand)
+	if err != nil {
+		log.WithError(err).Error("Failed to expand schedules")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to expand schedules",
+		})
+		return
+	}
+
 	log.Info("Schedule retrieved successfully")
-	c.JSON(http.StatusOK, schedule)
+	c.JSON(http.StatusOK, schedules[0])
 }
 
 // GetScheduleByGameKey handles the request to get a schedule by GameKey
@@ -106,6 +105,13 @@ func (h *Handler) GetScheduleByGameKey(c *gin.Context) {
 	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetScheduleByGameKey").WithField("game_key", gameKey)
 	log.Info("GetScheduleByGameKey requested")
 
+	q := newQueryParams(c)
+	expand := q.expandParam(expandStadium)
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
 	schedule, err := h.schedulesRepo.FindByGameKey(c.Request.Context(), gameKey)
 	if err != nil {
 		log.WithError(err).Error("Failed to get schedule")
@@ -123,6 +129,15 @@ func (h *Handler) GetScheduleByGameKey(c *gin.Context) {
 		return
 	}
 
+	schedules, err := h.expandSchedules(c.Request.Context(), []models.Schedule{*schedule}, expand)
+	if err != nil {
+		log.WithError(err).Error("Failed to expand schedules")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to expand schedules",
+		})
+		return
+	}
+
 	log.Info("Schedule retrieved successfully")
-	c.JSON(http.StatusOK, schedule)
+	c.JSON(http.StatusOK, schedules[0])
 }
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// GetStadiums handles the request to get all stadiums
+func (h *Handler) GetStadiums(c *gin.Context) {
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetStadiums")
+	log.Info("GetStadiums requested")
+
+	q := newQueryParams(c)
+	filter := &repositories.StadiumFilter{
+		State:           q.stringParam("state"),
+		PlayingSurfaces: q.listParam("surface"),
+		Types:           q.listParam("type"),
+	}
+
+	opts, err := parseListOptions(c, models.Stadium{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	page, err := h.stadiumsRepo.List(c.Request.Context(), filter, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to get stadiums")
+		respondListError(c, err, "Failed to get stadiums")
+		return
+	}
+
+	log.WithField("count", len(page.Items)).Info("Stadiums retrieved successfully")
+	respondPage(c, page, opts)
+}
+
+// GetStadiumGames handles the request to get the games played at a stadium
+func (h *Handler) GetStadiumGames(c *gin.Context) {
+	stadiumIDStr := c.Param("id")
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetStadiumGames").WithField("stadium_id", stadiumIDStr)
+	log.Info("GetStadiumGames requested")
+
+	stadiumID, err := strconv.Atoi(stadiumIDStr)
+	if err != nil {
+		log.WithError(err).Error("Invalid stadium ID format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid stadium ID format",
+		})
+		return
+	}
+
+	q := newQueryParams(c)
+	filter := &repositories.GameFilter{
+		MatchFilter: parseMatchFilter(q),
+	}
+	filter.StadiumID = &stadiumID
+	expand := q.expandParam(expandStadium, expandOdds)
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	opts, err := parseListOptions(c, models.Game{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	stadium, err := h.stadiumsRepo.FindByStadiumID(c.Request.Context(), stadiumID)
+	if err != nil {
+		log.WithError(err).Error("Failed to get stadium")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get stadium",
+		})
+		return
+	}
+
+	if stadium == nil {
+		log.Info("Stadium not found")
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Stadium not found",
+		})
+		return
+	}
+
+	page, err := h.gamesRepo.List(c.Request.Context(), filter, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to get stadium games")
+		respondListError(c, err, "Failed to get stadium games")
+		return
+	}
+
+	games, err := h.expandGames(c.Request.Context(), page.Items, expand)
+	if err != nil {
+		log.WithError(err).Error("Failed to expand games")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to expand games",
+		})
+		return
+	}
+
+	log.WithField("count", len(page.Items)).Info("Stadium games retrieved successfully")
+	respondPage(c, pageOf(page, games), opts)
+}
@@ -6,7 +6,10 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
 	"github.com/web-dev-jesus/trendzone/internal/logger"
+	"github.com/web-dev-jesus/trendzone/internal/standings"
 )
 
 // GetStandings handles the request to get all standings
@@ -18,30 +21,36 @@ func (h *Handler) GetStandings(c *gin.Context) {
 	conference := c.Query("conference")
 	division := c.Query("division")
 
-	var standings interface{}
-	var err error
+	opts, err := parseListOptions(c, models.Standing{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
 
 	// Apply filters
+	filter := &repositories.StandingFilter{}
 	if conference != "" && division != "" {
 		log.WithFields(logrus.Fields{
 			"conference": conference,
 			"division":   division,
 		}).Info("Getting standings by division")
-		standings, err = h.standingsRepo.FindByDivision(c.Request.Context(), conference, division)
+		filter.Conference = conference
+		filter.Division = division
 	} else {
 		log.Info("Getting all standings")
-		standings, err = h.standingsRepo.FindAll(c.Request.Context())
 	}
 
+	page, err := h.standingsRepo.List(c.Request.Context(), filter, opts)
 	if err != nil {
 		log.WithError(err).Error("Failed to get standings")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to get standings",
-		})
+		respondListError(c, err, "Failed to get standings")
 		return
 	}
 
-	c.JSON(http.StatusOK, standings)
+	respondPage(c, page, opts)
 }
 
 // GetStandingByID handles the request to get a standing by ID
@@ -97,3 +106,57 @@ func (h *Handler) GetStandingByTeam(c *gin.Context) {
 	log.Info("Standing retrieved successfully")
 	c.JSON(http.StatusOK, standing)
 }
+
+// GetComputedStandings handles the request to compute the standings and playoff seeding of a season
+// from its game results, as of the end of a week
+func (h *Handler) GetComputedStandings(c *gin.Context) {
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetComputedStandings")
+	log.Info("GetComputedStandings requested")
+
+	q := newQueryParams(c)
+	season, _ := h.seasonParams(q)
+	throughWeek := q.intParam("throughWeek")
+	if throughWeek != nil && *throughWeek < 1 {
+		q.fail("throughWeek", "must be a positive integer")
+	}
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	teams, err := h.teamsRepo.FindAll(c.Request.Context())
+	if err != nil {
+		log.WithError(err).Error("Failed to get teams")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get teams",
+		})
+		return
+	}
+
+	// Standings only count regular season games
+	games, err := h.gamesRepo.FindBySeason(c.Request.Context(), season, 1)
+	if err != nil {
+		log.WithError(err).Error("Failed to get games")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get games",
+		})
+		return
+	}
+	if throughWeek != nil {
+		played := games[:0]
+		for _, game := range games {
+			if game.Week <= *throughWeek {
+				played = append(played, game)
+			}
+		}
+		games = played
+	}
+
+	computed := standings.Compute(season, teams, games)
+
+	log.WithFields(logrus.Fields{
+		"season":       season,
+		"through_week": computed.ThroughWeek,
+	}).Info("Standings computed successfully")
+	c.JSON(http.StatusOK, computed)
+}
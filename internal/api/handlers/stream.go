@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/events"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+const streamHeartbeatInterval = 15 * time.Second
+
+// StreamGames handles the Server-Sent Events stream of game updates
+func (h *Handler) StreamGames(c *gin.Context) {
+	team := strings.ToUpper(c.Query("team"))
+	gameKey := c.Query("gameKey")
+	log := logger.WithRequestContext(c.Request.Context()).WithFields(logrus.Fields{
+		"component": "handlers.StreamGames",
+		"team":      team,
+		"game_key":  gameKey,
+	})
+	log.Info("StreamGames requested")
+
+	// Browsers send Last-Event-ID on reconnect; allow a query parameter for clients that can't set headers
+	lastEventIDStr := c.GetHeader("Last-Event-ID")
+	if lastEventIDStr == "" {
+		lastEventIDStr = c.Query("lastEventId")
+	}
+
+	var lastEventID uint64
+	if lastEventIDStr != "" {
+		id, err := strconv.ParseUint(lastEventIDStr, 10, 64)
+		if err != nil {
+			log.WithError(err).Error("Invalid Last-Event-ID format")
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid Last-Event-ID format",
+			})
+			return
+		}
+		lastEventID = id
+	}
+
+	matches := func(event *events.Event) bool {
+		if event.Type != events.TypeGameUpdated {
+			return false
+		}
+		if team != "" && !event.HasTopic(events.TeamTopic(team)) {
+			return false
+		}
+		if gameKey != "" && !event.HasTopic(events.GameTopic(gameKey)) {
+			return false
+		}
+		return true
+	}
+
+	sub, backlog := h.broker.Subscribe(lastEventID)
+	defer sub.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	send := func(event *events.Event) bool {
+		if !matches(event) {
+			return true
+		}
+		data, err := json.Marshal(event.Data)
+		if err != nil {
+			log.WithError(err).Error("Failed to encode event")
+			return true
+		}
+		if _, err := fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+			return false
+		}
+		c.Writer.Flush()
+		return true
+	}
+
+	for i := range backlog {
+		if !send(&backlog[i]) {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			log.Info("Stream client disconnected")
+			return
+		case <-h.broker.Done():
+			log.Info("Server shutting down, closing stream")
+			return
+		case event, ok := <-sub.C:
+			if !ok {
+				// The broker dropped us for falling behind; the client reconnects with Last-Event-ID
+				log.Warn("Stream subscriber fell behind, closing stream")
+				return
+			}
+			if !send(&event) {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(c.Writer, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+const (
+	defaultSyncJobsLimit = 20
+	maxSyncJobsLimit     = 100
+)
+
+// GetSyncJobs handles the request to list the most recent sync jobs
+func (h *Handler) GetSyncJobs(c *gin.Context) {
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetSyncJobs")
+	log.Info("GetSyncJobs requested")
+
+	limit := defaultSyncJobsLimit
+	if limitStr := c.Query("limit"); limitStr != "" {
+		l, err := strconv.Atoi(limitStr)
+		if err != nil || l < 1 || l > maxSyncJobsLimit {
+			log.WithField("limit", limitStr).Error("Invalid limit format")
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid limit format, must be between 1 and 100",
+			})
+			return
+		}
+		limit = l
+	}
+
+	jobs, err := h.syncJobsRepo.FindRecent(c.Request.Context(), int64(limit))
+	if err != nil {
+		log.WithError(err).Error("Failed to get sync jobs")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get sync jobs",
+		})
+		return
+	}
+
+	log.WithField("count", len(jobs)).Info("Sync jobs retrieved successfully")
+	c.JSON(http.StatusOK, jobs)
+}
+
+// GetSyncJobByID handles the request to get a sync job by JobID
+func (h *Handler) GetSyncJobByID(c *gin.Context) {
+	jobID := c.Param("jobID")
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetSyncJobByID").WithField("job_id", jobID)
+	log.Info("GetSyncJobByID requested")
+
+	job, err := h.syncJobsRepo.FindByJobID(c.Request.Context(), jobID)
+	if err != nil {
+		log.WithError(err).Error("Failed to get sync job")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get sync job",
+		})
+		return
+	}
+
+	if job == nil {
+		log.Info("Sync job not found")
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Sync job not found",
+		})
+		return
+	}
+
+	log.Info("Sync job retrieved successfully")
+	c.JSON(http.StatusOK, job)
+}
+
+// GetSportsDataMetrics handles the request to get SportsData.io request metrics per endpoint
+func (h *Handler) GetSportsDataMetrics(c *gin.Context) {
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetSportsDataMetrics")
+	log.Info("GetSportsDataMetrics requested")
+
+	c.JSON(http.StatusOK, h.sportsDataService.ClientMetrics())
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// GetTeamSeasonStats handles the request to get a team's season statistics
+func (h *Handler) GetTeamSeasonStats(c *gin.Context) {
+	key := c.Param("key")
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetTeamSeasonStats").WithField("team_key", key)
+	log.Info("GetTeamSeasonStats requested")
+
+	q := newQueryParams(c)
+	filter := &repositories.TeamSeasonStatsFilter{
+		Team:       key,
+		Season:     q.intParam("season"),
+		SeasonType: q.intParam("seasonType"),
+	}
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	opts, err := parseListOptions(c, models.TeamSeasonStats{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, ok := h.teamByKey(c, log, key); !ok {
+		return
+	}
+
+	page, err := h.teamSeasonStatsRepo.List(c.Request.Context(), filter, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to get team season stats")
+		respondListError(c, err, "Failed to get team season stats")
+		return
+	}
+
+	log.WithField("count", len(page.Items)).Info("Team season stats retrieved successfully")
+	respondPage(c, page, opts)
+}
+
+// GetTeamGameStats handles the request to get a team's statistics game by game
+func (h *Handler) GetTeamGameStats(c *gin.Context) {
+	key := c.Param("key")
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetTeamGameStats").WithField("team_key", key)
+	log.Info("GetTeamGameStats requested")
+
+	q := newQueryParams(c)
+	filter := &repositories.TeamGameStatsFilter{
+		Team:       key,
+		Season:     q.intParam("season"),
+		SeasonType: q.intParam("seasonType"),
+	}
+	filter.WeekFrom, filter.WeekTo = q.weekRange()
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	opts, err := parseListOptions(c, models.TeamGameStats{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, ok := h.teamByKey(c, log, key); !ok {
+		return
+	}
+
+	page, err := h.teamGameStatsRepo.List(c.Request.Context(), filter, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to get team game stats")
+		respondListError(c, err, "Failed to get team game stats")
+		return
+	}
+
+	log.WithField("count", len(page.Items)).Info("Team game stats retrieved successfully")
+	respondPage(c, page, opts)
+}
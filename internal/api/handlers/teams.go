@@ -4,7 +4,9 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
 
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
 	"github.com/web-dev-jesus/trendzone/internal/logger"
 )
 
@@ -13,17 +15,40 @@ func (h *Handler) GetTeams(c *gin.Context) {
 	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetTeams")
 	log.Info("GetTeams requested")
 
-	teams, err := h.teamsRepo.FindAll(c.Request.Context())
+	q := newQueryParams(c)
+	expand := q.expandParam(expandStadium)
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	opts, err := parseListOptions(c, models.Team{})
+	if err != nil {
+		log.WithError(err).Error("Invalid list options")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	page, err := h.teamsRepo.List(c.Request.Context(), opts)
 	if err != nil {
 		log.WithError(err).Error("Failed to get teams")
+		respondListError(c, err, "Failed to get teams")
+		return
+	}
+
+	teams, err := h.expandTeams(c.Request.Context(), page.Items, expand)
+	if err != nil {
+		log.WithError(err).Error("Failed to expand teams")
 		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to get teams",
+			"error": "Failed to expand teams",
 		})
 		return
 	}
 
-	log.WithField("count", len(teams)).Info("Teams retrieved successfully")
-	c.JSON(http.StatusOK, teams)
+	log.WithField("count", len(page.Items)).Info("Teams retrieved successfully")
+	respondPage(c, pageOf(page, teams), opts)
 }
 
 // GetTeamByID handles the request to get a team by ID
@@ -32,6 +57,13 @@ func (h *Handler) GetTeamByID(c *gin.Context) {
 	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetTeamByID").WithField("team_id", id)
 	log.Info("GetTeamByID requested")
 
+	q := newQueryParams(c)
+	expand := q.expandParam(expandStadium)
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
 	team, err := h.teamsRepo.FindByID(c.Request.Context(), id)
 	if err != nil {
 		log.WithError(err).Error("Failed to get team")
@@ -49,8 +81,17 @@ func (h *Handler) GetTeamByID(c *gin.Context) {
 		return
 	}
 
+	teams, err := h.expandTeams(c.Request.Context(), []models.Team{*team}, expand)
+	if err != nil {
+		log.WithError(err).Error("Failed to expand teams")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to expand teams",
+		})
+		return
+	}
+
 	log.Info("Team retrieved successfully")
-	c.JSON(http.StatusOK, team)
+	c.JSON(http.StatusOK, teams[0])
 }
 
 // GetTeamByKey handles the request to get a team by key (abbreviation)
@@ -59,13 +100,40 @@ func (h *Handler) GetTeamByKey(c *gin.Context) {
 	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetTeamByKey").WithField("team_key", key)
 	log.Info("GetTeamByKey requested")
 
+	q := newQueryParams(c)
+	expand := q.expandParam(expandStadium)
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	team, ok := h.teamByKey(c, log, key)
+	if !ok {
+		return
+	}
+
+	teams, err := h.expandTeams(c.Request.Context(), []models.Team{*team}, expand)
+	if err != nil {
+		log.WithError(err).Error("Failed to expand teams")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to expand teams",
+		})
+		return
+	}
+
+	log.Info("Team retrieved successfully")
+	c.JSON(http.StatusOK, teams[0])
+}
+
+// teamByKey loads the team with the given key, writing a 404 or 500 response and returning false if it can't
+func (h *Handler) teamByKey(c *gin.Context, log *logrus.Entry, key string) (*models.Team, bool) {
 	team, err := h.teamsRepo.FindByKey(c.Request.Context(), key)
 	if err != nil {
 		log.WithError(err).Error("Failed to get team")
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to get team",
 		})
-		return
+		return nil, false
 	}
 
 	if team == nil {
@@ -73,9 +141,8 @@ func (h *Handler) GetTeamByKey(c *gin.Context) {
 		c.JSON(http.StatusNotFound, gin.H{
 			"error": "Team not found",
 		})
-		return
+		return nil, false
 	}
 
-	log.Info("Team retrieved successfully")
-	c.JSON(http.StatusOK, team)
+	return team, true
 }
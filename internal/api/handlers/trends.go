@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/analytics"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+const (
+	defaultLeaderboardLimit = 32
+	maxLeaderboardLimit     = 100
+)
+
+// GetTeamTrends handles the request to get a team's against-the-spread and over/under trends for a season
+func (h *Handler) GetTeamTrends(c *gin.Context) {
+	key := strings.ToUpper(c.Param("key"))
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetTeamTrends").WithField("team_key", key)
+	log.Info("GetTeamTrends requested")
+
+	q := newQueryParams(c)
+	season, seasonType := h.seasonParams(q)
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	if _, ok := h.teamByKey(c, log, key); !ok {
+		return
+	}
+
+	trends, err := h.analyticsService.TeamTrends(c.Request.Context(), key, season, seasonType)
+	if err != nil {
+		log.WithError(err).Error("Failed to compute team trends")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to compute team trends",
+		})
+		return
+	}
+
+	log.Info("Team trends retrieved successfully")
+	c.JSON(http.StatusOK, trends)
+}
+
+// GetTrendsLeaderboard handles the request to rank teams by a trend metric
+func (h *Handler) GetTrendsLeaderboard(c *gin.Context) {
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetTrendsLeaderboard")
+	log.Info("GetTrendsLeaderboard requested")
+
+	q := newQueryParams(c)
+	season, seasonType := h.seasonParams(q)
+	metric := q.stringParam("metric")
+	if metric == "" {
+		metric = analytics.MetricATS
+	} else if !analytics.IsMetric(metric) {
+		q.fail("metric", "must be ats, cover_margin, over or under")
+	}
+	split := q.stringParam("split")
+	if split == "" {
+		split = analytics.SplitAll
+	} else if !analytics.IsSplit(split) {
+		q.fail("split", "must be all, home, away, favorite or underdog")
+	}
+	limit := defaultLeaderboardLimit
+	if l := q.intParam("limit"); l != nil {
+		if *l < 1 || *l > maxLeaderboardLimit {
+			q.fail("limit", "must be between 1 and %d", maxLeaderboardLimit)
+		}
+		limit = *l
+	}
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	entries, err := h.analyticsService.Leaderboard(c.Request.Context(), season, seasonType, metric, split, limit)
+	if err != nil {
+		log.WithError(err).Error("Failed to compute trends leaderboard")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to compute trends leaderboard",
+		})
+		return
+	}
+
+	log.WithFields(logrus.Fields{
+		"metric": metric,
+		"count":  len(entries),
+	}).Info("Trends leaderboard retrieved successfully")
+	c.JSON(http.StatusOK, gin.H{
+		"season":     season,
+		"seasonType": seasonType,
+		"metric":     metric,
+		"split":      split,
+		"teams":      entries,
+	})
+}
+
+// GetStreaks handles the request to list the teams' current and longest streaks
+func (h *Handler) GetStreaks(c *gin.Context) {
+	log := logger.WithRequestContext(c.Request.Context()).WithField("component", "handlers.GetStreaks")
+	log.Info("GetStreaks requested")
+
+	q := newQueryParams(c)
+	filter := &analytics.StreakFilter{
+		Team:  strings.ToUpper(q.stringParam("team")),
+		Types: q.listParam("type"),
+	}
+	for _, streakType := range filter.Types {
+		if !analytics.IsStreakType(streakType) {
+			q.fail("type", "unknown streak type %q", streakType)
+		}
+	}
+	if minLength := q.intParam("minLength"); minLength != nil {
+		if *minLength < 1 {
+			q.fail("minLength", "must be a positive integer")
+		}
+		filter.MinLength = *minLength
+	}
+	if q.respondInvalid() {
+		log.WithField("invalid_params", q.invalid).Error("Invalid query parameters")
+		return
+	}
+
+	streaks, err := h.analyticsService.Streaks(c.Request.Context(), filter)
+	if err != nil {
+		log.WithError(err).Error("Failed to retrieve streaks")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to retrieve streaks",
+		})
+		return
+	}
+
+	log.WithField("count", len(streaks)).Info("Streaks retrieved successfully")
+	c.JSON(http.StatusOK, gin.H{
+		"streaks": streaks,
+	})
+}
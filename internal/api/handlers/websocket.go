@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/api/middleware"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+const (
+	wsWriteWait      = 10 * time.Second
+	wsPongWait       = 60 * time.Second
+	wsPingPeriod     = (wsPongWait * 9) / 10
+	wsMaxMessageSize = 4096
+)
+
+// Topic prefixes clients may subscribe to, e.g. "game:<GameKey>" or "standings:<Conference>/<Division>"
+var wsTopicPrefixes = map[string]bool{
+	"game":      true,
+	"team":      true,
+	"player":    true,
+	"standings": true,
+}
+
+// wsClientMessage is a subscription request sent by the client
+type wsClientMessage struct {
+	Action string `json:"action"`
+	Topic  string `json:"topic"`
+}
+
+// wsServerMessage is sent to the client in reply to a request or when a subscribed document changes
+type wsServerMessage struct {
+	Type   string                 `json:"type"`
+	Topic  string                 `json:"topic,omitempty"`
+	Topics []string               `json:"topics,omitempty"`
+	Event  string                 `json:"event,omitempty"`
+	ID     uint64                 `json:"id,omitempty"`
+	Diff   map[string]interface{} `json:"diff,omitempty"`
+	Time   *time.Time             `json:"time,omitempty"`
+	Error  string                 `json:"error,omitempty"`
+}
+
+// WebSocket handles the subscription WebSocket for game, team, player and standings updates
+func (h *Handler) WebSocket(c *gin.Context) {
+	userID := middleware.UserID(c.Request.Context())
+	log := logger.WithRequestContext(c.Request.Context()).WithFields(logrus.Fields{
+		"component": "handlers.WebSocket",
+		"user_id":   userID,
+	})
+	log.Info("WebSocket requested")
+
+	maxSubscriptions := h.config.WebSocket.MaxSubscriptions
+	if userID != "" {
+		maxSubscriptions = h.config.WebSocket.MaxSubscriptionsAuthenticated
+	}
+
+	upgrader := websocket.Upgrader{
+		CheckOrigin: h.checkWebSocketOrigin,
+	}
+
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// The upgrader has already written an HTTP error response
+		log.WithError(err).Warn("Failed to upgrade WebSocket connection")
+		return
+	}
+	defer conn.Close()
+
+	sub, _ := h.broker.Subscribe(0)
+	defer sub.Close()
+
+	// Read client messages on their own goroutine; all writes happen on this one
+	incoming := make(chan []byte)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(incoming)
+
+		conn.SetReadLimit(wsMaxMessageSize)
+		conn.SetReadDeadline(time.Now().Add(wsPongWait))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(wsPongWait))
+		})
+
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+					log.WithError(err).Warn("WebSocket read failed")
+				}
+				return
+			}
+			select {
+			case incoming <- data:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	write := func(msg wsServerMessage) bool {
+		conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+		if err := conn.WriteJSON(msg); err != nil {
+			log.WithError(err).Warn("WebSocket write failed")
+			return false
+		}
+		return true
+	}
+
+	ping := time.NewTicker(wsPingPeriod)
+	defer ping.Stop()
+
+	subscriptions := map[string]bool{}
+
+	for {
+		select {
+		case data, ok := <-incoming:
+			if !ok {
+				log.Info("WebSocket client disconnected")
+				return
+			}
+			if !write(handleWebSocketMessage(data, subscriptions, maxSubscriptions)) {
+				return
+			}
+
+		case event, ok := <-sub.C:
+			if !ok {
+				log.Warn("WebSocket subscriber fell behind, closing connection")
+				conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+				conn.WriteMessage(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "subscriber fell behind"))
+				return
+			}
+
+			subscribed := false
+			for _, topic := range event.Topics {
+				subscribed = subscribed || subscriptions[topic]
+			}
+			if !subscribed {
+				continue
+			}
+
+			if !write(wsServerMessage{
+				Type:   "event",
+				Event:  event.Type,
+				ID:     event.ID,
+				Topics: event.Topics,
+				Diff:   event.Diff,
+				Time:   &event.Time,
+			}) {
+				return
+			}
+
+		case <-ping.C:
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// handleWebSocketMessage applies a subscribe or unsubscribe request and returns the reply
+func handleWebSocketMessage(data []byte, subscriptions map[string]bool, maxSubscriptions int) wsServerMessage {
+	var msg wsClientMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return wsServerMessage{Type: "error", Error: "Invalid message format"}
+	}
+
+	if !validWebSocketTopic(msg.Topic) {
+		return wsServerMessage{Type: "error", Topic: msg.Topic, Error: "Invalid topic"}
+	}
+
+	switch msg.Action {
+	case "subscribe":
+		if !subscriptions[msg.Topic] && len(subscriptions) >= maxSubscriptions {
+			return wsServerMessage{Type: "error", Topic: msg.Topic, Error: "Subscription limit reached"}
+		}
+		subscriptions[msg.Topic] = true
+		return wsServerMessage{Type: "subscribed", Topic: msg.Topic}
+	case "unsubscribe":
+		delete(subscriptions, msg.Topic)
+		return wsServerMessage{Type: "unsubscribed", Topic: msg.Topic}
+	default:
+		return wsServerMessage{Type: "error", Topic: msg.Topic, Error: "Unknown action, must be subscribe or unsubscribe"}
+	}
+}
+
+// validWebSocketTopic checks that the topic has a known prefix and a non-empty key
+func validWebSocketTopic(topic string) bool {
+	prefix, key, ok := strings.Cut(topic, ":")
+	if !ok || key == "" || !wsTopicPrefixes[prefix] {
+		return false
+	}
+	if prefix == "standings" {
+		conference, division, ok := strings.Cut(key, "/")
+		return ok && conference != "" && division != ""
+	}
+	return true
+}
+
+// checkWebSocketOrigin allows same-origin requests plus the configured origins ("*" allows any)
+func (h *Handler) checkWebSocketOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	for _, allowed := range h.config.WebSocket.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+
+	return strings.EqualFold(strings.TrimPrefix(strings.TrimPrefix(origin, "https://"), "http://"), r.Host)
+}
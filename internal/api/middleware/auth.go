@@ -15,6 +15,11 @@ import (
 	"github.com/web-dev-jesus/trendzone/internal/logger"
 )
 
+var (
+	ErrInvalidClaims = errors.New("invalid token claims")
+	ErrTokenExpired  = errors.New("token expired")
+)
+
 type Claims struct {
 	UserID string `json:"user_id"`
 	Role   string `json:"role"`
@@ -46,47 +51,74 @@ func AuthMiddleware(cfg *config.AppConfig) gin.HandlerFunc {
 		}
 
 		// Parse and validate the token
-		token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-			// Validate signing method
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, errors.New("invalid token signing method")
+		claims, err := ParseToken(cfg, tokenString)
+		if err != nil {
+			switch {
+			case errors.Is(err, ErrInvalidClaims):
+				log.Warn("Invalid token claims")
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+					"error": "Invalid token claims",
+				})
+			case errors.Is(err, ErrTokenExpired):
+				log.Warn("Token expired")
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+					"error": "Token expired",
+				})
+			default:
+				log.WithError(err).Warn("Failed to parse or validate token")
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+					"error": "Invalid or expired token",
+				})
 			}
+			return
+		}
 
-			// Return the secret key
-			return []byte(cfg.Secret), nil
-		})
+		// Add claims to context
+		setClaims(c, claims)
 
-		if err != nil {
-			log.WithError(err).Warn("Failed to parse or validate token")
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "Invalid or expired token",
-			})
-			return
+		log.WithFields(logrus.Fields{
+			"user_id": claims.UserID,
+			"role":    claims.Role,
+		}).Info("User authenticated")
+
+		c.Next()
+	}
+}
+
+// OptionalAuthMiddleware authenticates the request like AuthMiddleware when a token is supplied,
+// either as a Bearer Authorization header or as a "token" query parameter (for clients such as
+// browser WebSockets that cannot set headers), and lets anonymous requests through otherwise.
+func OptionalAuthMiddleware(cfg *config.AppConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		log := logger.WithRequestContext(c.Request.Context()).WithField("component", "optional_auth_middleware")
+
+		tokenString := c.Query("token")
+		if authHeader := c.GetHeader("Authorization"); authHeader != "" {
+			tokenString = strings.TrimPrefix(authHeader, "Bearer ")
+			if tokenString == authHeader {
+				log.Warn("Invalid Authorization header format")
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+					"error": "Authorization header must be in format: Bearer {token}",
+				})
+				return
+			}
 		}
 
-		// Check if the token is valid
-		claims, ok := token.Claims.(*Claims)
-		if !ok || !token.Valid {
-			log.Warn("Invalid token claims")
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "Invalid token claims",
-			})
+		if tokenString == "" {
+			c.Next()
 			return
 		}
 
-		// Check token expiration
-		if claims.ExpiresAt.Time.Before(time.Now()) {
-			log.Warn("Token expired")
+		claims, err := ParseToken(cfg, tokenString)
+		if err != nil {
+			log.WithError(err).Warn("Failed to parse or validate token")
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "Token expired",
+				"error": "Invalid or expired token",
 			})
 			return
 		}
 
-		// Add claims to context
-		ctx := context.WithValue(c.Request.Context(), "user_id", claims.UserID)
-		ctx = context.WithValue(ctx, "role", claims.Role)
-		c.Request = c.Request.WithContext(ctx)
+		setClaims(c, claims)
 
 		log.WithFields(logrus.Fields{
 			"user_id": claims.UserID,
@@ -96,3 +128,45 @@ func AuthMiddleware(cfg *config.AppConfig) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// ParseToken validates a signed token and returns its claims
+func ParseToken(cfg *config.AppConfig, tokenString string) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		// Validate signing method
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("invalid token signing method")
+		}
+
+		// Return the secret key
+		return []byte(cfg.Secret), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the token is valid
+	claims, ok := token.Claims.(*Claims)
+	if !ok || !token.Valid {
+		return nil, ErrInvalidClaims
+	}
+
+	// Check token expiration
+	if claims.ExpiresAt == nil || claims.ExpiresAt.Time.Before(time.Now()) {
+		return nil, ErrTokenExpired
+	}
+
+	return claims, nil
+}
+
+// UserID returns the authenticated user's ID, or "" for anonymous requests
+func UserID(ctx context.Context) string {
+	userID, _ := ctx.Value("user_id").(string)
+	return userID
+}
+
+// setClaims adds the token claims to the request context
+func setClaims(c *gin.Context, claims *Claims) {
+	ctx := context.WithValue(c.Request.Context(), "user_id", claims.UserID)
+	ctx = context.WithValue(ctx, "role", claims.Role)
+	c.Request = c.Request.WithContext(ctx)
+}
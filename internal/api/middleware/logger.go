@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"net/url"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -9,15 +10,36 @@ import (
 	"github.com/web-dev-jesus/trendzone/internal/logger"
 )
 
+// redactedQueryParams hold credentials and are never logged
+var redactedQueryParams = []string{"token"}
+
+// redactedURL returns a copy of u with the values of redactedQueryParams replaced
+func redactedURL(u *url.URL) *url.URL {
+	redacted := *u
+	query := u.Query()
+	changed := false
+	for _, param := range redactedQueryParams {
+		if query.Has(param) {
+			query.Set(param, "REDACTED")
+			changed = true
+		}
+	}
+	if changed {
+		redacted.RawQuery = query.Encode()
+	}
+	return &redacted
+}
+
 func LoggerMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Start timer
 		startTime := time.Now()
 
 		// Create a request context with request information
+		loggedURL := redactedURL(c.Request.URL)
 		requestCtx := logger.NewRequestContext(
 			c.Request.Context(),
-			c.Request.URL.String(),
+			loggedURL.String(),
 			c.ClientIP(),
 		)
 		c.Request = c.Request.WithContext(requestCtx)
@@ -32,7 +54,7 @@ func LoggerMiddleware() gin.HandlerFunc {
 		statusCode := c.Writer.Status()
 		method := c.Request.Method
 		path := c.Request.URL.Path
-		query := c.Request.URL.RawQuery
+		query := loggedURL.RawQuery
 		userAgent := c.Request.UserAgent()
 
 		// Get the error if there was one
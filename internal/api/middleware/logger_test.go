@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestRedactedURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want string
+	}{
+		{name: "no query", url: "/api/v1/ws", want: "/api/v1/ws"},
+		{name: "no token", url: "/api/v1/games?season=2023&week=5", want: "/api/v1/games?season=2023&week=5"},
+		{name: "token", url: "/api/v1/ws?token=eyJhbGciOi.eyJzdWIi.c2lnbmF0dXJl", want: "/api/v1/ws?token=REDACTED"},
+		{name: "token among other params", url: "/api/v1/ws?a=1&token=secret", want: "/api/v1/ws?a=1&token=REDACTED"},
+		{name: "empty token", url: "/api/v1/ws?token=", want: "/api/v1/ws?token=REDACTED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.url)
+			if err != nil {
+				t.Fatalf("parse %q: %v", tt.url, err)
+			}
+			if got := redactedURL(u).String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if u.String() != tt.url {
+				t.Errorf("original URL changed to %q", u.String())
+			}
+		})
+	}
+}
@@ -31,19 +31,56 @@ func SetupRouter(cfg *config.Config, handler *handlers.Handler) *gin.Engine {
 		apiV1.GET("/teams", handler.GetTeams)
 		apiV1.GET("/teams/:id", handler.GetTeamByID)
 		apiV1.GET("/teams/key/:key", handler.GetTeamByKey)
+		apiV1.GET("/teams/key/:key/stats", handler.GetTeamSeasonStats)
+		apiV1.GET("/teams/key/:key/games/stats", handler.GetTeamGameStats)
+		apiV1.GET("/teams/key/:key/depth-chart", handler.GetTeamDepthChart)
+
+		// Depth charts
+		apiV1.GET("/depth-chart/changes", handler.GetDepthChartChanges)
 
 		// Players
 		apiV1.GET("/players", handler.GetPlayers)
+		apiV1.GET("/players/search", handler.SearchPlayers)
 		apiV1.GET("/players/:id", handler.GetPlayerByID)
 		apiV1.GET("/players/pid/:playerID", handler.GetPlayerByPlayerID)
+		apiV1.GET("/players/pid/:playerID/games", handler.GetPlayerGameStats)
+		apiV1.GET("/players/pid/:playerID/injuries", handler.GetPlayerInjuries)
 
 		// Games
 		apiV1.GET("/games", handler.GetGames)
+		apiV1.GET("/games/stream", handler.StreamGames)
 		apiV1.GET("/games/:id", handler.GetGameByID)
 		apiV1.GET("/games/key/:gameKey", handler.GetGameByGameKey)
+		apiV1.GET("/games/key/:gameKey/players", handler.GetGamePlayerStats)
+		apiV1.GET("/games/key/:gameKey/plays", handler.GetGamePlays)
+		apiV1.GET("/games/key/:gameKey/drives", handler.GetGameDrives)
+		apiV1.GET("/games/key/:gameKey/odds/history", handler.GetGameOddsHistory)
+
+		// Stadiums
+		apiV1.GET("/stadiums", handler.GetStadiums)
+		apiV1.GET("/stadiums/:id/games", handler.GetStadiumGames)
+
+		// Trends
+		apiV1.GET("/trends/teams/:key", handler.GetTeamTrends)
+		apiV1.GET("/trends/leaderboard", handler.GetTrendsLeaderboard)
+		apiV1.GET("/trends/streaks", handler.GetStreaks)
+
+		// Matchups
+		apiV1.GET("/matchups/:teamA/:teamB", handler.GetMatchup)
+
+		// Projections
+		apiV1.GET("/projections/playoffs", handler.GetPlayoffProjections)
+
+		// Ratings
+		apiV1.GET("/ratings", handler.GetRatings)
+		apiV1.GET("/ratings/teams/:key/history", handler.GetTeamRatingHistory)
+
+		// Injuries
+		apiV1.GET("/injuries", handler.GetInjuries)
 
 		// Standings
 		apiV1.GET("/standings", handler.GetStandings)
+		apiV1.GET("/standings/computed", handler.GetComputedStandings)
 		apiV1.GET("/standings/:id", handler.GetStandingByID)
 		apiV1.GET("/standings/team/:team", handler.GetStandingByTeam)
 
@@ -52,12 +89,21 @@ func SetupRouter(cfg *config.Config, handler *handlers.Handler) *gin.Engine {
 		apiV1.GET("/schedules/:id", handler.GetScheduleByID)
 		apiV1.GET("/schedules/key/:gameKey", handler.GetScheduleByGameKey)
 
+		// Live updates (authentication optional, raises the subscription limit)
+		apiV1.GET("/ws", middleware.OptionalAuthMiddleware(&cfg.App), handler.WebSocket)
+
 		// Protected routes (require authentication)
 		adminRoutes := apiV1.Group("/admin")
 		adminRoutes.Use(middleware.AuthMiddleware(&cfg.App))
 		{
 			// Data sync
 			adminRoutes.POST("/sync", handler.SyncData)
+			adminRoutes.GET("/sync", handler.GetSyncJobs)
+			adminRoutes.GET("/sync/:jobID", handler.GetSyncJobByID)
+			adminRoutes.GET("/sportsdata/metrics", handler.GetSportsDataMetrics)
+
+			// Ratings
+			adminRoutes.POST("/ratings/recompute", handler.RecomputeRatings)
 		}
 	}
 
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// DepthChartEntry is a player's place on a depth chart. DepthOrder 1 is the starter.
+type DepthChartEntry struct {
+	PlayerID   int    `bson:"PlayerID" json:"playerID"`
+	Name       string `bson:"Name" json:"name"`
+	DepthOrder int    `bson:"DepthOrder" json:"depthOrder"`
+}
+
+// DepthChart is a team's current depth chart at one position
+type DepthChart struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	TeamID           int                `bson:"TeamID" json:"teamID"`
+	Team             string             `bson:"Team" json:"team"`
+	Position         string             `bson:"Position" json:"position"`
+	PositionCategory string             `bson:"PositionCategory" json:"positionCategory"`
+	Players          []DepthChartEntry  `bson:"Players" json:"players"`
+	LastUpdated      time.Time          `bson:"last_updated" json:"lastUpdated"`
+}
+
+// DepthChartSnapshot is a depth chart as it was from TakenAt until the next snapshot of the same team and position
+type DepthChartSnapshot struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	TeamID           int                `bson:"TeamID" json:"teamID"`
+	Team             string             `bson:"Team" json:"team"`
+	Position         string             `bson:"Position" json:"position"`
+	PositionCategory string             `bson:"PositionCategory" json:"positionCategory"`
+	Players          []DepthChartEntry  `bson:"Players" json:"players"`
+	TakenAt          time.Time          `bson:"TakenAt" json:"takenAt"`
+}
+
+// Depth chart change types
+const (
+	DepthChartPromoted = "promoted"
+	DepthChartDemoted  = "demoted"
+	DepthChartAdded    = "added"
+	DepthChartRemoved  = "removed"
+)
+
+// DepthChartChange is a player's move on a depth chart between two snapshots.
+// FromDepth is 0 for an added player and ToDepth is 0 for a removed one.
+type DepthChartChange struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	Team       string             `bson:"Team" json:"team"`
+	Position   string             `bson:"Position" json:"position"`
+	PlayerID   int                `bson:"PlayerID" json:"playerID"`
+	Name       string             `bson:"Name" json:"name"`
+	Type       string             `bson:"Type" json:"type"`
+	FromDepth  int                `bson:"FromDepth" json:"fromDepth"`
+	ToDepth    int                `bson:"ToDepth" json:"toDepth"`
+	DetectedAt time.Time          `bson:"DetectedAt" json:"detectedAt"`
+}
@@ -6,6 +6,18 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// Game statuses reported by SportsData.io
+const (
+	GameStatusScheduled  = "Scheduled"
+	GameStatusInProgress = "InProgress"
+	GameStatusFinal      = "Final"
+	GameStatusFinalOT    = "F/OT"
+	GameStatusSuspended  = "Suspended"
+	GameStatusPostponed  = "Postponed"
+	GameStatusCanceled   = "Canceled"
+	GameStatusForfeit    = "Forfeit"
+)
+
 type Weather struct {
 	Temperature         int    `bson:"Temperature" json:"temperature"`
 	Humidity            int    `bson:"Humidity" json:"humidity"`
@@ -26,7 +38,9 @@ type Game struct {
 	AwayScore         int                `bson:"AwayScore" json:"awayScore"`
 	HomeScore         int                `bson:"HomeScore" json:"homeScore"`
 	Channel           string             `bson:"Channel" json:"channel"`
+	StadiumID         int                `bson:"StadiumID" json:"stadiumID"`
 	Stadium           string             `bson:"Stadium" json:"stadium"`
+	NeutralVenue      bool               `bson:"NeutralVenue" json:"neutralVenue"`
 	Status            string             `bson:"Status" json:"status"`
 	Quarter           string             `bson:"Quarter" json:"quarter"`
 	TimeRemaining     string             `bson:"TimeRemaining" json:"timeRemaining"`
@@ -53,3 +67,12 @@ type Game struct {
 	Weather           Weather            `bson:"Weather" json:"weather"`
 	LastUpdated       time.Time          `bson:"last_updated" json:"lastUpdated"`
 }
+
+// IsFinal reports whether the game is over and its score will no longer change
+func (g *Game) IsFinal() bool {
+	switch g.Status {
+	case GameStatusFinal, GameStatusFinalOT, GameStatusCanceled, GameStatusForfeit:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Injury is a player's injury designation for one week. One is stored per player and week,
+// so a player's designations over a season form their injury history.
+type Injury struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	InjuryID   int                `bson:"InjuryID" json:"injuryID"`
+	PlayerID   int                `bson:"PlayerID" json:"playerID"`
+	SeasonType int                `bson:"SeasonType" json:"seasonType"`
+	Season     int                `bson:"Season" json:"season"`
+	Week       int                `bson:"Week" json:"week"`
+	Name       string             `bson:"Name" json:"name"`
+	Position   string             `bson:"Position" json:"position"`
+	Number     int                `bson:"Number" json:"number"`
+	Team       string             `bson:"Team" json:"team"`
+	Opponent   string             `bson:"Opponent" json:"opponent"`
+	BodyPart   string             `bson:"BodyPart" json:"bodyPart"`
+	// Status is the game status designation, e.g. Questionable, Doubtful or Out
+	Status string `bson:"Status" json:"status"`
+	// Practice is the practice participation, e.g. Full, Limited or DNP
+	Practice            string    `bson:"Practice" json:"practice"`
+	PracticeDescription string    `bson:"PracticeDescription" json:"practiceDescription"`
+	DeclaredInactive    bool      `bson:"DeclaredInactive" json:"declaredInactive"`
+	Updated             time.Time `bson:"Updated" json:"updated"`
+	LastUpdated         time.Time `bson:"last_updated" json:"lastUpdated"`
+}
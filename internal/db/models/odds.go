@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OddsSportsbookConsensus is the sportsbook of the lines carried by the games and schedules feeds
+const OddsSportsbookConsensus = "Consensus"
+
+// OddsSnapshot is a game's betting line at one point in time. A snapshot is stored each time the line moves,
+// so the snapshots of a game are its line movement history.
+type OddsSnapshot struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	GameKey    string             `bson:"GameKey" json:"gameKey"`
+	Season     int                `bson:"Season" json:"season"`
+	SeasonType int                `bson:"SeasonType" json:"seasonType"`
+	Week       int                `bson:"Week" json:"week"`
+	Sportsbook string             `bson:"Sportsbook" json:"sportsbook"`
+	OddsLine   `bson:",inline"`
+}
+
+// OddsLine is a betting line. PointSpread is from the home team's side, so a negative spread means the home team is favored.
+type OddsLine struct {
+	PointSpread       float64   `bson:"PointSpread" json:"pointSpread"`
+	OverUnder         float64   `bson:"OverUnder" json:"overUnder"`
+	HomeTeamMoneyLine int       `bson:"HomeTeamMoneyLine" json:"homeTeamMoneyLine"`
+	AwayTeamMoneyLine int       `bson:"AwayTeamMoneyLine" json:"awayTeamMoneyLine"`
+	TakenAt           time.Time `bson:"TakenAt" json:"takenAt"`
+}
+
+// SameLine reports whether two lines have the same numbers, whenever they were taken
+func (l *OddsLine) SameLine(other *OddsLine) bool {
+	return l.PointSpread == other.PointSpread &&
+		l.OverUnder == other.OverUnder &&
+		l.HomeTeamMoneyLine == other.HomeTeamMoneyLine &&
+		l.AwayTeamMoneyLine == other.AwayTeamMoneyLine
+}
+
+// OddsSummary compares a game's opening line, its first snapshot, with its closing line, its last snapshot
+type OddsSummary struct {
+	GameKey    string   `bson:"GameKey" json:"-"`
+	Sportsbook string   `bson:"Sportsbook" json:"sportsbook"`
+	Opening    OddsLine `bson:"Opening" json:"opening"`
+	Closing    OddsLine `bson:"Closing" json:"closing"`
+	// SpreadMovement and TotalMovement are the closing minus the opening spread and over/under
+	SpreadMovement float64 `bson:"-" json:"spreadMovement"`
+	TotalMovement  float64 `bson:"-" json:"totalMovement"`
+	Snapshots      int     `bson:"Snapshots" json:"snapshots"`
+}
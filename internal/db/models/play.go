@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Play is a single play of a game's play-by-play
+type Play struct {
+	ID       primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	PlayID   int                `bson:"PlayID" json:"playID"`
+	GameKey  string             `bson:"GameKey" json:"gameKey"`
+	ScoreID  int                `bson:"ScoreID" json:"scoreID"`
+	Sequence int                `bson:"Sequence" json:"sequence"`
+	// Quarter is 1 to 4, or OT
+	Quarter              string `bson:"Quarter" json:"quarter"`
+	TimeRemainingMinutes int    `bson:"TimeRemainingMinutes" json:"timeRemainingMinutes"`
+	TimeRemainingSeconds int    `bson:"TimeRemainingSeconds" json:"timeRemainingSeconds"`
+	// Team is the team in possession, Opponent the team on defense
+	Team              string `bson:"Team" json:"team"`
+	Opponent          string `bson:"Opponent" json:"opponent"`
+	Down              int    `bson:"Down" json:"down"`
+	Distance          int    `bson:"Distance" json:"distance"`
+	YardLine          int    `bson:"YardLine" json:"yardLine"`
+	YardLineTerritory string `bson:"YardLineTerritory" json:"yardLineTerritory"`
+	YardsToEndZone    int    `bson:"YardsToEndZone" json:"yardsToEndZone"`
+	Type              string `bson:"Type" json:"type"`
+	YardsGained       int    `bson:"YardsGained" json:"yardsGained"`
+	Description       string `bson:"Description" json:"description"`
+	IsScoringPlay     bool   `bson:"IsScoringPlay" json:"isScoringPlay"`
+	// DriveNumber is the drive the play belongs to; 0 for plays outside a drive such as kickoffs and timeouts
+	DriveNumber int       `bson:"DriveNumber" json:"driveNumber"`
+	LastUpdated time.Time `bson:"last_updated" json:"lastUpdated"`
+}
+
+// Drive results
+const (
+	DriveResultTouchdown       = "Touchdown"
+	DriveResultFieldGoal       = "Field Goal"
+	DriveResultMissedFieldGoal = "Missed Field Goal"
+	DriveResultPunt            = "Punt"
+	DriveResultTurnover        = "Turnover"
+	DriveResultDowns           = "Downs"
+	DriveResultSafety          = "Safety"
+	DriveResultEndOfHalf       = "End of Half"
+	DriveResultEndOfGame       = "End of Game"
+	DriveResultUnknown         = "Unknown"
+)
+
+// Drive summarizes a team's consecutive possession plays. Drives are derived from the plays of a game.
+type Drive struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	GameKey      string             `bson:"GameKey" json:"gameKey"`
+	ScoreID      int                `bson:"ScoreID" json:"scoreID"`
+	Number       int                `bson:"Number" json:"number"`
+	Team         string             `bson:"Team" json:"team"`
+	StartQuarter string             `bson:"StartQuarter" json:"startQuarter"`
+	EndQuarter   string             `bson:"EndQuarter" json:"endQuarter"`
+	// StartTime and EndTime are the time remaining in the quarter, formatted as M:SS
+	StartTime           string    `bson:"StartTime" json:"startTime"`
+	EndTime             string    `bson:"EndTime" json:"endTime"`
+	StartYardsToEndZone int       `bson:"StartYardsToEndZone" json:"startYardsToEndZone"`
+	Plays               int       `bson:"Plays" json:"plays"`
+	Yards               int       `bson:"Yards" json:"yards"`
+	Result              string    `bson:"Result" json:"result"`
+	LastUpdated         time.Time `bson:"last_updated" json:"lastUpdated"`
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PlayerGameStats is a player's box score line for one game. SportsData.io reports
+// stat values as decimals, so they are stored as float64 even where they are counts.
+type PlayerGameStats struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	PlayerGameID     int                `bson:"PlayerGameID" json:"playerGameID"`
+	PlayerID         int                `bson:"PlayerID" json:"playerID"`
+	GameKey          string             `bson:"GameKey" json:"gameKey"`
+	ScoreID          int                `bson:"ScoreID" json:"scoreID"`
+	SeasonType       int                `bson:"SeasonType" json:"seasonType"`
+	Season           int                `bson:"Season" json:"season"`
+	Week             int                `bson:"Week" json:"week"`
+	GameDate         time.Time          `bson:"GameDate" json:"gameDate"`
+	Team             string             `bson:"Team" json:"team"`
+	Opponent         string             `bson:"Opponent" json:"opponent"`
+	HomeOrAway       string             `bson:"HomeOrAway" json:"homeOrAway"`
+	Number           int                `bson:"Number" json:"number"`
+	Name             string             `bson:"Name" json:"name"`
+	Position         string             `bson:"Position" json:"position"`
+	PositionCategory string             `bson:"PositionCategory" json:"positionCategory"`
+	Played           int                `bson:"Played" json:"played"`
+	Started          int                `bson:"Started" json:"started"`
+
+	PassingAttempts      float64 `bson:"PassingAttempts" json:"passingAttempts"`
+	PassingCompletions   float64 `bson:"PassingCompletions" json:"passingCompletions"`
+	PassingYards         float64 `bson:"PassingYards" json:"passingYards"`
+	PassingTouchdowns    float64 `bson:"PassingTouchdowns" json:"passingTouchdowns"`
+	PassingInterceptions float64 `bson:"PassingInterceptions" json:"passingInterceptions"`
+	PassingRating        float64 `bson:"PassingRating" json:"passingRating"`
+	PassingSacks         float64 `bson:"PassingSacks" json:"passingSacks"`
+	PassingLong          float64 `bson:"PassingLong" json:"passingLong"`
+
+	RushingAttempts   float64 `bson:"RushingAttempts" json:"rushingAttempts"`
+	RushingYards      float64 `bson:"RushingYards" json:"rushingYards"`
+	RushingTouchdowns float64 `bson:"RushingTouchdowns" json:"rushingTouchdowns"`
+	RushingLong       float64 `bson:"RushingLong" json:"rushingLong"`
+
+	ReceivingTargets    float64 `bson:"ReceivingTargets" json:"receivingTargets"`
+	Receptions          float64 `bson:"Receptions" json:"receptions"`
+	ReceivingYards      float64 `bson:"ReceivingYards" json:"receivingYards"`
+	ReceivingTouchdowns float64 `bson:"ReceivingTouchdowns" json:"receivingTouchdowns"`
+	ReceivingLong       float64 `bson:"ReceivingLong" json:"receivingLong"`
+
+	Fumbles     float64 `bson:"Fumbles" json:"fumbles"`
+	FumblesLost float64 `bson:"FumblesLost" json:"fumblesLost"`
+
+	SoloTackles         float64 `bson:"SoloTackles" json:"soloTackles"`
+	AssistedTackles     float64 `bson:"AssistedTackles" json:"assistedTackles"`
+	Sacks               float64 `bson:"Sacks" json:"sacks"`
+	TacklesForLoss      float64 `bson:"TacklesForLoss" json:"tacklesForLoss"`
+	QuarterbackHits     float64 `bson:"QuarterbackHits" json:"quarterbackHits"`
+	PassesDefended      float64 `bson:"PassesDefended" json:"passesDefended"`
+	Interceptions       float64 `bson:"Interceptions" json:"interceptions"`
+	FumblesForced       float64 `bson:"FumblesForced" json:"fumblesForced"`
+	FumblesRecovered    float64 `bson:"FumblesRecovered" json:"fumblesRecovered"`
+	DefensiveTouchdowns float64 `bson:"DefensiveTouchdowns" json:"defensiveTouchdowns"`
+
+	FieldGoalsAttempted  float64 `bson:"FieldGoalsAttempted" json:"fieldGoalsAttempted"`
+	FieldGoalsMade       float64 `bson:"FieldGoalsMade" json:"fieldGoalsMade"`
+	ExtraPointsAttempted float64 `bson:"ExtraPointsAttempted" json:"extraPointsAttempted"`
+	ExtraPointsMade      float64 `bson:"ExtraPointsMade" json:"extraPointsMade"`
+
+	FantasyPoints    float64   `bson:"FantasyPoints" json:"fantasyPoints"`
+	FantasyPointsPPR float64   `bson:"FantasyPointsPPR" json:"fantasyPointsPPR"`
+	LastUpdated      time.Time `bson:"last_updated" json:"lastUpdated"`
+}
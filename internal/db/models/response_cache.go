@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ResponseCache holds the validators of the last SportsData.io response that was synced for a URL
+type ResponseCache struct {
+	ID primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	// URL is the request URL without the API key
+	URL          string    `bson:"URL" json:"url"`
+	ETag         string    `bson:"ETag" json:"etag,omitempty"`
+	LastModified string    `bson:"LastModified" json:"lastModified,omitempty"`
+	ContentHash  string    `bson:"ContentHash" json:"contentHash"`
+	LastUpdated  time.Time `bson:"last_updated" json:"lastUpdated"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Stadium roof types
+const (
+	StadiumTypeOutdoor         = "Outdoor"
+	StadiumTypeDome            = "Dome"
+	StadiumTypeRetractableDome = "RetractableDome"
+)
+
+type Stadium struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	StadiumID int                `bson:"StadiumID" json:"stadiumID"`
+	Name      string             `bson:"Name" json:"name"`
+	City      string             `bson:"City" json:"city"`
+	State     string             `bson:"State" json:"state"`
+	Country   string             `bson:"Country" json:"country"`
+	Capacity  int                `bson:"Capacity" json:"capacity"`
+	// PlayingSurface is Grass, Artificial or Dome
+	PlayingSurface string `bson:"PlayingSurface" json:"playingSurface"`
+	// Type is the roof type: Outdoor, Dome or RetractableDome
+	Type        string    `bson:"Type" json:"type"`
+	GeoLat      float64   `bson:"GeoLat" json:"geoLat"`
+	GeoLong     float64   `bson:"GeoLong" json:"geoLong"`
+	LastUpdated time.Time `bson:"last_updated" json:"lastUpdated"`
+}
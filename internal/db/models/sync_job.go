@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	SyncJobStatusRunning   = "running"
+	SyncJobStatusCompleted = "completed"
+	SyncJobStatusFailed    = "failed"
+)
+
+const (
+	SyncTriggerManual    = "manual"
+	SyncTriggerScheduler = "scheduler"
+)
+
+type SyncResult struct {
+	Entity       string `bson:"Entity" json:"entity"`
+	SuccessCount int    `bson:"SuccessCount" json:"successCount"`
+	FailureCount int    `bson:"FailureCount" json:"failureCount"`
+	TotalCount   int    `bson:"TotalCount" json:"totalCount"`
+	// Breakdown of SuccessCount by what the write did
+	InsertedCount  int `bson:"InsertedCount" json:"insertedCount"`
+	ModifiedCount  int `bson:"ModifiedCount" json:"modifiedCount"`
+	UnchangedCount int `bson:"UnchangedCount" json:"unchangedCount"`
+	// Skipped is set when the SportsData.io payload was unchanged since the last sync, so nothing was written
+	Skipped bool `bson:"Skipped" json:"skipped"`
+	// Error is set when the entity's sync failed
+	Error string `bson:"Error,omitempty" json:"error,omitempty"`
+}
+
+type SyncJob struct {
+	ID     primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	JobID  string             `bson:"JobID" json:"jobID"`
+	Season string             `bson:"Season" json:"season"`
+	// Week is the week synced for weekly entities such as player game stats
+	Week        int          `bson:"Week" json:"week,omitempty"`
+	Trigger     string       `bson:"Trigger" json:"trigger"`
+	Force       bool         `bson:"Force" json:"force"`
+	Entities    []string     `bson:"Entities" json:"entities"`
+	Status      string       `bson:"Status" json:"status"`
+	Results     []SyncResult `bson:"Results" json:"results"`
+	StartedAt   time.Time    `bson:"StartedAt" json:"startedAt"`
+	FinishedAt  *time.Time   `bson:"FinishedAt" json:"finishedAt"`
+	Error       string       `bson:"Error" json:"error,omitempty"`
+	LastUpdated time.Time    `bson:"last_updated" json:"lastUpdated"`
+}
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TeamStats holds the offensive and defensive statistics shared by game-level and season-level team stats.
+// Opponent* fields are what the team allowed on defense.
+type TeamStats struct {
+	Score         int `bson:"Score" json:"score"`
+	OpponentScore int `bson:"OpponentScore" json:"opponentScore"`
+
+	FirstDowns            int     `bson:"FirstDowns" json:"firstDowns"`
+	ThirdDownConversions  int     `bson:"ThirdDownConversions" json:"thirdDownConversions"`
+	ThirdDownAttempts     int     `bson:"ThirdDownAttempts" json:"thirdDownAttempts"`
+	ThirdDownPercentage   float64 `bson:"ThirdDownPercentage" json:"thirdDownPercentage"`
+	FourthDownConversions int     `bson:"FourthDownConversions" json:"fourthDownConversions"`
+	FourthDownAttempts    int     `bson:"FourthDownAttempts" json:"fourthDownAttempts"`
+	RedZoneAttempts       int     `bson:"RedZoneAttempts" json:"redZoneAttempts"`
+	RedZoneConversions    int     `bson:"RedZoneConversions" json:"redZoneConversions"`
+
+	OffensivePlays        int     `bson:"OffensivePlays" json:"offensivePlays"`
+	OffensiveYards        int     `bson:"OffensiveYards" json:"offensiveYards"`
+	OffensiveYardsPerPlay float64 `bson:"OffensiveYardsPerPlay" json:"offensiveYardsPerPlay"`
+	PassingAttempts       int     `bson:"PassingAttempts" json:"passingAttempts"`
+	PassingCompletions    int     `bson:"PassingCompletions" json:"passingCompletions"`
+	PassingYards          int     `bson:"PassingYards" json:"passingYards"`
+	PassingTouchdowns     int     `bson:"PassingTouchdowns" json:"passingTouchdowns"`
+	PassingInterceptions  int     `bson:"PassingInterceptions" json:"passingInterceptions"`
+	RushingAttempts       int     `bson:"RushingAttempts" json:"rushingAttempts"`
+	RushingYards          int     `bson:"RushingYards" json:"rushingYards"`
+	RushingTouchdowns     int     `bson:"RushingTouchdowns" json:"rushingTouchdowns"`
+	TimesSacked           int     `bson:"TimesSacked" json:"timesSacked"`
+
+	Fumbles              int `bson:"Fumbles" json:"fumbles"`
+	FumblesLost          int `bson:"FumblesLost" json:"fumblesLost"`
+	Giveaways            int `bson:"Giveaways" json:"giveaways"`
+	Takeaways            int `bson:"Takeaways" json:"takeaways"`
+	TurnoverDifferential int `bson:"TurnoverDifferential" json:"turnoverDifferential"`
+	Penalties            int `bson:"Penalties" json:"penalties"`
+	PenaltyYards         int `bson:"PenaltyYards" json:"penaltyYards"`
+
+	// TimeOfPossession is formatted as MM:SS
+	TimeOfPossession        string `bson:"TimeOfPossession" json:"timeOfPossession"`
+	TimeOfPossessionMinutes int    `bson:"TimeOfPossessionMinutes" json:"timeOfPossessionMinutes"`
+	TimeOfPossessionSeconds int    `bson:"TimeOfPossessionSeconds" json:"timeOfPossessionSeconds"`
+
+	Sacks                        float64 `bson:"Sacks" json:"sacks"`
+	QuarterbackHits              int     `bson:"QuarterbackHits" json:"quarterbackHits"`
+	TacklesForLoss               float64 `bson:"TacklesForLoss" json:"tacklesForLoss"`
+	PassesDefended               int     `bson:"PassesDefended" json:"passesDefended"`
+	InterceptionReturnTouchdowns int     `bson:"InterceptionReturnTouchdowns" json:"interceptionReturnTouchdowns"`
+
+	OpponentFirstDowns           int     `bson:"OpponentFirstDowns" json:"opponentFirstDowns"`
+	OpponentThirdDownConversions int     `bson:"OpponentThirdDownConversions" json:"opponentThirdDownConversions"`
+	OpponentThirdDownAttempts    int     `bson:"OpponentThirdDownAttempts" json:"opponentThirdDownAttempts"`
+	OpponentThirdDownPercentage  float64 `bson:"OpponentThirdDownPercentage" json:"opponentThirdDownPercentage"`
+	OpponentOffensivePlays       int     `bson:"OpponentOffensivePlays" json:"opponentOffensivePlays"`
+	OpponentOffensiveYards       int     `bson:"OpponentOffensiveYards" json:"opponentOffensiveYards"`
+	OpponentPassingYards         int     `bson:"OpponentPassingYards" json:"opponentPassingYards"`
+	OpponentRushingYards         int     `bson:"OpponentRushingYards" json:"opponentRushingYards"`
+	OpponentTimeOfPossession     string  `bson:"OpponentTimeOfPossession" json:"opponentTimeOfPossession"`
+}
+
+// TeamGameStats is a team's statistics for one game
+type TeamGameStats struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	TeamGameID  int                `bson:"TeamGameID" json:"teamGameID"`
+	GameKey     string             `bson:"GameKey" json:"gameKey"`
+	ScoreID     int                `bson:"ScoreID" json:"scoreID"`
+	SeasonType  int                `bson:"SeasonType" json:"seasonType"`
+	Season      int                `bson:"Season" json:"season"`
+	Week        int                `bson:"Week" json:"week"`
+	Date        time.Time          `bson:"Date" json:"date"`
+	Team        string             `bson:"Team" json:"team"`
+	Opponent    string             `bson:"Opponent" json:"opponent"`
+	HomeOrAway  string             `bson:"HomeOrAway" json:"homeOrAway"`
+	TeamStats   `bson:",inline"`
+	LastUpdated time.Time `bson:"last_updated" json:"lastUpdated"`
+}
+
+// TeamSeasonStats is a team's accumulated statistics for one season and season type
+type TeamSeasonStats struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	SeasonType  int                `bson:"SeasonType" json:"seasonType"`
+	Season      int                `bson:"Season" json:"season"`
+	Team        string             `bson:"Team" json:"team"`
+	Games       int                `bson:"Games" json:"games"`
+	TeamStats   `bson:",inline"`
+	LastUpdated time.Time `bson:"last_updated" json:"lastUpdated"`
+}
@@ -0,0 +1,316 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// IndexSpec declares an index the repositories rely on
+type IndexSpec struct {
+	Name   string
+	Keys   bson.D
+	Unique bool
+	// Weights applies to text indexes only
+	Weights bson.D
+}
+
+// indexRegistry lists the indexes of every collection. Unique indexes match the key each UpsertBy* method replaces on.
+var indexRegistry = map[string][]IndexSpec{
+	"teams": {
+		{Name: "teams_team_id", Keys: bson.D{{Key: "TeamID", Value: 1}}, Unique: true},
+		{Name: "teams_key", Keys: bson.D{{Key: "Key", Value: 1}}},
+	},
+	"players": {
+		{Name: "players_player_id", Keys: bson.D{{Key: "PlayerID", Value: 1}}, Unique: true},
+		{Name: "players_team", Keys: bson.D{{Key: "Team", Value: 1}}},
+		{
+			Name: "player_name_text",
+			Keys: bson.D{
+				{Key: "Name", Value: "text"},
+				{Key: "LastName", Value: "text"},
+				{Key: "FirstName", Value: "text"},
+			},
+			// A match on the full name ranks above first or last name alone
+			Weights: bson.D{
+				{Key: "Name", Value: 3},
+				{Key: "LastName", Value: 2},
+				{Key: "FirstName", Value: 1},
+			},
+		},
+	},
+	"depth_charts": {
+		{Name: "depth_charts_team_position", Keys: bson.D{{Key: "TeamID", Value: 1}, {Key: "Position", Value: 1}}, Unique: true},
+		{Name: "depth_charts_team", Keys: bson.D{{Key: "Team", Value: 1}}},
+	},
+	"depth_chart_snapshots": {
+		{Name: "depth_chart_snapshots_team_taken_at", Keys: bson.D{{Key: "Team", Value: 1}, {Key: "TakenAt", Value: -1}}},
+	},
+	"depth_chart_changes": {
+		{Name: "depth_chart_changes_detected_at", Keys: bson.D{{Key: "DetectedAt", Value: -1}}},
+		{Name: "depth_chart_changes_team_detected_at", Keys: bson.D{{Key: "Team", Value: 1}, {Key: "DetectedAt", Value: -1}}},
+	},
+	"games": {
+		{Name: "games_game_key", Keys: bson.D{{Key: "GameKey", Value: 1}}, Unique: true},
+		{Name: "games_season_week", Keys: bson.D{{Key: "Season", Value: 1}, {Key: "Week", Value: 1}}},
+		{Name: "games_home_team", Keys: bson.D{{Key: "HomeTeam", Value: 1}, {Key: "Date", Value: 1}}},
+		{Name: "games_away_team", Keys: bson.D{{Key: "AwayTeam", Value: 1}, {Key: "Date", Value: 1}}},
+		{Name: "games_date", Keys: bson.D{{Key: "Date", Value: 1}}},
+		{Name: "games_stadium_date", Keys: bson.D{{Key: "StadiumID", Value: 1}, {Key: "Date", Value: 1}}},
+	},
+	"schedules": {
+		{Name: "schedules_game_key", Keys: bson.D{{Key: "GameKey", Value: 1}}, Unique: true},
+		{Name: "schedules_season_week", Keys: bson.D{{Key: "Season", Value: 1}, {Key: "Week", Value: 1}}},
+		{Name: "schedules_home_team", Keys: bson.D{{Key: "HomeTeam", Value: 1}, {Key: "Date", Value: 1}}},
+		{Name: "schedules_away_team", Keys: bson.D{{Key: "AwayTeam", Value: 1}, {Key: "Date", Value: 1}}},
+		{Name: "schedules_date_time", Keys: bson.D{{Key: "DateTime", Value: 1}}},
+	},
+	"stadiums": {
+		{Name: "stadiums_stadium_id", Keys: bson.D{{Key: "StadiumID", Value: 1}}, Unique: true},
+	},
+	"standings": {
+		{Name: "standings_team_season", Keys: bson.D{{Key: "Team", Value: 1}, {Key: "Season", Value: 1}}, Unique: true},
+		{Name: "standings_conference_division", Keys: bson.D{{Key: "Conference", Value: 1}, {Key: "Division", Value: 1}}},
+	},
+	"odds_snapshots": {
+		{Name: "odds_snapshots_game_sportsbook_taken_at", Keys: bson.D{{Key: "GameKey", Value: 1}, {Key: "Sportsbook", Value: 1}, {Key: "TakenAt", Value: 1}}},
+	},
+	"ratings": {
+		{Name: "ratings_team_order", Keys: bson.D{{Key: "Team", Value: 1}, {Key: "Order", Value: 1}}, Unique: true},
+		{Name: "ratings_season_order", Keys: bson.D{{Key: "Season", Value: 1}, {Key: "Order", Value: 1}}},
+		{Name: "ratings_order", Keys: bson.D{{Key: "Order", Value: 1}}},
+	},
+	"streaks": {
+		{Name: "streaks_team_type", Keys: bson.D{{Key: "Team", Value: 1}, {Key: "Type", Value: 1}}, Unique: true},
+		{Name: "streaks_current", Keys: bson.D{{Key: "Current", Value: -1}}},
+	},
+	"plays": {
+		{Name: "plays_play_id", Keys: bson.D{{Key: "PlayID", Value: 1}}, Unique: true},
+		{Name: "plays_game_sequence", Keys: bson.D{{Key: "GameKey", Value: 1}, {Key: "Sequence", Value: 1}}},
+	},
+	"drives": {
+		{Name: "drives_game_number", Keys: bson.D{{Key: "GameKey", Value: 1}, {Key: "Number", Value: 1}}, Unique: true},
+	},
+	"injuries": {
+		{Name: "injuries_player_week", Keys: bson.D{{Key: "PlayerID", Value: 1}, {Key: "Season", Value: 1}, {Key: "SeasonType", Value: 1}, {Key: "Week", Value: 1}}, Unique: true},
+		{Name: "injuries_team_week", Keys: bson.D{{Key: "Team", Value: 1}, {Key: "Season", Value: 1}, {Key: "Week", Value: 1}}},
+		{Name: "injuries_season_week", Keys: bson.D{{Key: "Season", Value: 1}, {Key: "SeasonType", Value: 1}, {Key: "Week", Value: 1}}},
+	},
+	"player_game_stats": {
+		{Name: "player_game_stats_player_game", Keys: bson.D{{Key: "PlayerID", Value: 1}, {Key: "GameKey", Value: 1}}, Unique: true},
+		{Name: "player_game_stats_game_key", Keys: bson.D{{Key: "GameKey", Value: 1}}},
+		{Name: "player_game_stats_player_season_week", Keys: bson.D{{Key: "PlayerID", Value: 1}, {Key: "Season", Value: 1}, {Key: "Week", Value: 1}}},
+	},
+	"team_game_stats": {
+		{Name: "team_game_stats_team_game", Keys: bson.D{{Key: "Team", Value: 1}, {Key: "GameKey", Value: 1}}, Unique: true},
+		{Name: "team_game_stats_game_key", Keys: bson.D{{Key: "GameKey", Value: 1}}},
+		{Name: "team_game_stats_team_season_week", Keys: bson.D{{Key: "Team", Value: 1}, {Key: "Season", Value: 1}, {Key: "Week", Value: 1}}},
+	},
+	"team_season_stats": {
+		{Name: "team_season_stats_team_season", Keys: bson.D{{Key: "Team", Value: 1}, {Key: "Season", Value: 1}, {Key: "SeasonType", Value: 1}}, Unique: true},
+		{Name: "team_season_stats_season", Keys: bson.D{{Key: "Season", Value: 1}}},
+	},
+	"sportsdata_cache": {
+		{Name: "sportsdata_cache_url", Keys: bson.D{{Key: "URL", Value: 1}}, Unique: true},
+	},
+	"sync_jobs": {
+		{Name: "sync_jobs_job_id", Keys: bson.D{{Key: "JobID", Value: 1}}, Unique: true},
+		{Name: "sync_jobs_started_at", Keys: bson.D{{Key: "StartedAt", Value: -1}}},
+	},
+}
+
+// IndexReport lists the indexes changed by EnsureIndexes as "collection.index"
+type IndexReport struct {
+	Created   []string `json:"created"`
+	Dropped   []string `json:"dropped"`
+	Unchanged []string `json:"unchanged"`
+	Failed    []string `json:"failed"`
+}
+
+// existingIndex is the subset of listIndexes output compared against an IndexSpec
+type existingIndex struct {
+	Name    string `bson:"name"`
+	Key     bson.D `bson:"key"`
+	Unique  bool   `bson:"unique"`
+	Weights bson.M `bson:"weights"`
+}
+
+// EnsureIndexes creates missing indexes and recreates ones whose definition changed.
+// With prune, indexes that are not in the registry are dropped as well.
+// It keeps going when an index fails, e.g. a unique index over duplicate documents, and returns the joined errors.
+func EnsureIndexes(ctx context.Context, db *mongo.Database, prune bool) (*IndexReport, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "mongodb.EnsureIndexes")
+	log.Info("Ensuring MongoDB indexes")
+
+	report := &IndexReport{Created: []string{}, Dropped: []string{}, Unchanged: []string{}, Failed: []string{}}
+	var errs []error
+
+	collectionNames := make([]string, 0, len(indexRegistry))
+	for name := range indexRegistry {
+		collectionNames = append(collectionNames, name)
+	}
+	sort.Strings(collectionNames)
+
+	for _, collectionName := range collectionNames {
+		if err := ensureCollectionIndexes(ctx, db.Collection(collectionName), indexRegistry[collectionName], prune, report); err != nil {
+			errs = append(errs, err...)
+		}
+	}
+
+	log.WithFields(logrus.Fields{
+		"created":   report.Created,
+		"dropped":   report.Dropped,
+		"unchanged": len(report.Unchanged),
+		"failed":    report.Failed,
+	}).Info("MongoDB indexes ensured")
+
+	return report, errors.Join(errs...)
+}
+
+func ensureCollectionIndexes(ctx context.Context, collection *mongo.Collection, specs []IndexSpec, prune bool, report *IndexReport) []error {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component":  "mongodb.EnsureIndexes",
+		"collection": collection.Name(),
+	})
+
+	existing, err := listIndexes(ctx, collection)
+	if err != nil {
+		log.WithError(err).Error("Failed to list indexes")
+		report.Failed = append(report.Failed, collection.Name())
+		return []error{fmt.Errorf("list indexes of %s: %w", collection.Name(), err)}
+	}
+
+	var errs []error
+	declared := map[string]bool{}
+
+	for _, spec := range specs {
+		declared[spec.Name] = true
+		qualified := collection.Name() + "." + spec.Name
+
+		if current, ok := existing[spec.Name]; ok {
+			if indexMatches(current, spec) {
+				report.Unchanged = append(report.Unchanged, qualified)
+				continue
+			}
+			// Index options can't be modified in place, so a changed definition is dropped and recreated
+			log.WithField("index", spec.Name).Info("Index definition changed, recreating")
+			if _, err := collection.Indexes().DropOne(ctx, spec.Name); err != nil {
+				log.WithError(err).WithField("index", spec.Name).Error("Failed to drop index")
+				report.Failed = append(report.Failed, qualified)
+				errs = append(errs, fmt.Errorf("drop index %s: %w", qualified, err))
+				continue
+			}
+			report.Dropped = append(report.Dropped, qualified)
+		}
+
+		if _, err := collection.Indexes().CreateOne(ctx, spec.model()); err != nil {
+			log.WithError(err).WithField("index", spec.Name).Error("Failed to create index")
+			report.Failed = append(report.Failed, qualified)
+			errs = append(errs, fmt.Errorf("create index %s: %w", qualified, err))
+			continue
+		}
+		log.WithField("index", spec.Name).Info("Index created")
+		report.Created = append(report.Created, qualified)
+	}
+
+	if prune {
+		for name := range existing {
+			if name == "_id_" || declared[name] {
+				continue
+			}
+			qualified := collection.Name() + "." + name
+			if _, err := collection.Indexes().DropOne(ctx, name); err != nil {
+				log.WithError(err).WithField("index", name).Error("Failed to drop undeclared index")
+				report.Failed = append(report.Failed, qualified)
+				errs = append(errs, fmt.Errorf("drop index %s: %w", qualified, err))
+				continue
+			}
+			log.WithField("index", name).Info("Undeclared index dropped")
+			report.Dropped = append(report.Dropped, qualified)
+		}
+	}
+
+	return errs
+}
+
+func listIndexes(ctx context.Context, collection *mongo.Collection) (map[string]existingIndex, error) {
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var indexes []existingIndex
+	if err := cursor.All(ctx, &indexes); err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]existingIndex, len(indexes))
+	for _, index := range indexes {
+		byName[index.Name] = index
+	}
+	return byName, nil
+}
+
+func (s IndexSpec) model() mongo.IndexModel {
+	opts := options.Index().SetName(s.Name)
+	if s.Unique {
+		opts.SetUnique(true)
+	}
+	if len(s.Weights) > 0 {
+		opts.SetWeights(s.Weights)
+	}
+	return mongo.IndexModel{Keys: s.Keys, Options: opts}
+}
+
+func (s IndexSpec) isText() bool {
+	for _, e := range s.Keys {
+		if e.Value == "text" {
+			return true
+		}
+	}
+	return false
+}
+
+// indexMatches compares an existing index with its declaration. Text indexes are stored with
+// internal keys, so they are compared by their weights instead.
+func indexMatches(existing existingIndex, spec IndexSpec) bool {
+	if existing.Unique != spec.Unique {
+		return false
+	}
+
+	if spec.isText() {
+		weights := map[string]string{}
+		for _, e := range spec.Keys {
+			weights[e.Key] = "1"
+		}
+		for _, e := range spec.Weights {
+			weights[e.Key] = fmt.Sprint(e.Value)
+		}
+		existingWeights := map[string]string{}
+		for key, value := range existing.Weights {
+			existingWeights[key] = fmt.Sprint(value)
+		}
+		return reflect.DeepEqual(weights, existingWeights)
+	}
+
+	if len(existing.Key) != len(spec.Keys) {
+		return false
+	}
+	for i, e := range spec.Keys {
+		// Numeric directions come back as int32 or double, so compare their printed form
+		if existing.Key[i].Key != e.Key || fmt.Sprint(existing.Key[i].Value) != fmt.Sprint(e.Value) {
+			return false
+		}
+	}
+	return true
+}
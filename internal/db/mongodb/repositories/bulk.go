@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// bulkBatchSize caps the number of writes sent in one BulkWrite call
+const bulkBatchSize = 500
+
+// BulkCounts is the outcome of a bulk upsert. Unchanged documents matched an existing
+// document that was already identical, so nothing was written.
+type BulkCounts struct {
+	Inserted  int `json:"inserted"`
+	Modified  int `json:"modified"`
+	Unchanged int `json:"unchanged"`
+	Failed    int `json:"failed"`
+}
+
+// BulkResult is the outcome of a bulk upsert, per batch and in total
+type BulkResult struct {
+	BulkCounts
+	Batches []BulkCounts
+	// Errors maps the index of each document that could not be written to its error
+	Errors map[int]error
+}
+
+func (c *BulkCounts) add(other BulkCounts) {
+	c.Inserted += other.Inserted
+	c.Modified += other.Modified
+	c.Unchanged += other.Unchanged
+	c.Failed += other.Failed
+}
+
+// bulkUpsert replaces each document matched by key, inserting it if it doesn't exist, in unordered
+// batches so that one failed write doesn't stop the rest. Failed writes, including whole batches
+// that failed e.g. on a lost connection, are reported in BulkResult.Errors; an error is only
+// returned when the context is done.
+func bulkUpsert[T any](ctx context.Context, collection *mongo.Collection, docs []T, key func(*T) bson.M) (*BulkResult, error) {
+	result := &BulkResult{Errors: map[int]error{}}
+
+	for start := 0; start < len(docs); start += bulkBatchSize {
+		end := min(start+bulkBatchSize, len(docs))
+
+		models := make([]mongo.WriteModel, 0, end-start)
+		for i := start; i < end; i++ {
+			models = append(models, mongo.NewReplaceOneModel().
+				SetFilter(key(&docs[i])).
+				SetReplacement(&docs[i]).
+				SetUpsert(true))
+		}
+
+		res, err := collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+
+		var counts BulkCounts
+		var bulkErr mongo.BulkWriteException
+		switch {
+		case err == nil || errors.As(err, &bulkErr):
+			for _, writeErr := range bulkErr.WriteErrors {
+				result.Errors[start+writeErr.Index] = writeErr
+			}
+			counts = BulkCounts{
+				Inserted:  int(res.UpsertedCount),
+				Modified:  int(res.ModifiedCount),
+				Unchanged: int(res.MatchedCount - res.ModifiedCount),
+				Failed:    len(bulkErr.WriteErrors),
+			}
+		default:
+			for i := start; i < end; i++ {
+				result.Errors[i] = err
+			}
+			counts = BulkCounts{Failed: end - start}
+		}
+
+		result.Batches = append(result.Batches, counts)
+		result.add(counts)
+
+		// Later batches can't succeed once the context is done
+		if ctx.Err() != nil {
+			for i := end; i < len(docs); i++ {
+				result.Errors[i] = ctx.Err()
+			}
+			result.Failed += len(docs) - end
+			return result, ctx.Err()
+		}
+	}
+
+	return result, nil
+}
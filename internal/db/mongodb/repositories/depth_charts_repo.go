@@ -0,0 +1,228 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// DepthChartsRepository stores the current depth charts together with their snapshot history
+// and the changes detected between snapshots
+type DepthChartsRepository struct {
+	collection *mongo.Collection
+	snapshots  *mongo.Collection
+	changes    *mongo.Collection
+}
+
+func NewDepthChartsRepository(client *mongo.Database) *DepthChartsRepository {
+	return &DepthChartsRepository{
+		collection: client.Collection("depth_charts"),
+		snapshots:  client.Collection("depth_chart_snapshots"),
+		changes:    client.Collection("depth_chart_changes"),
+	}
+}
+
+// DepthChartChangeFilter selects depth chart changes for ListChanges. Every set field is ANDed together.
+type DepthChartChangeFilter struct {
+	Team     string
+	Position string
+	Types    []string
+	// Since is inclusive
+	Since *time.Time
+}
+
+func (f *DepthChartChangeFilter) bson() bson.M {
+	var conds []bson.M
+
+	if f.Team != "" {
+		conds = append(conds, bson.M{"Team": f.Team})
+	}
+	if f.Position != "" {
+		conds = append(conds, bson.M{"Position": f.Position})
+	}
+	if len(f.Types) > 0 {
+		conds = append(conds, bson.M{"Type": bson.M{"$in": f.Types}})
+	}
+	if f.Since != nil {
+		conds = append(conds, bson.M{"DetectedAt": bson.M{"$gte": *f.Since}})
+	}
+
+	return and(conds)
+}
+
+func (r *DepthChartsRepository) FindAll(ctx context.Context) ([]models.DepthChart, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "depth_charts_repository.FindAll")
+	log.Info("Fetching all depth charts")
+
+	var charts []models.DepthChart
+	cursor, err := r.collection.Find(ctx, bson.M{})
+	if err != nil {
+		log.WithError(err).Error("Failed to find depth charts")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &charts); err != nil {
+		log.WithError(err).Error("Failed to decode depth charts")
+		return nil, err
+	}
+
+	log.WithField("count", len(charts)).Info("Depth charts retrieved successfully")
+	return charts, nil
+}
+
+func (r *DepthChartsRepository) FindByTeam(ctx context.Context, team string) ([]models.DepthChart, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "depth_charts_repository.FindByTeam").WithField("team", team)
+	log.Info("Finding depth charts by team")
+
+	opts := options.Find().SetSort(bson.D{{Key: "PositionCategory", Value: 1}, {Key: "Position", Value: 1}})
+
+	charts := []models.DepthChart{}
+	cursor, err := r.collection.Find(ctx, bson.M{"Team": team}, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to find depth charts by team")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &charts); err != nil {
+		log.WithError(err).Error("Failed to decode depth charts")
+		return nil, err
+	}
+
+	log.WithField("count", len(charts)).Info("Depth charts retrieved successfully")
+	return charts, nil
+}
+
+// FindSnapshotsAsOf returns the latest snapshot taken at or before asOf of each of the team's positions
+func (r *DepthChartsRepository) FindSnapshotsAsOf(ctx context.Context, team string, asOf time.Time) ([]models.DepthChartSnapshot, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "depth_charts_repository.FindSnapshotsAsOf",
+		"team":      team,
+		"as_of":     asOf,
+	})
+	log.Info("Finding depth chart snapshots as of a time")
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"Team": team, "TakenAt": bson.M{"$lte": asOf}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "TakenAt", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": "$Position",
+			"doc": bson.M{"$first": "$$ROOT"},
+		}}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$doc"}}},
+		{{Key: "$sort", Value: bson.D{{Key: "PositionCategory", Value: 1}, {Key: "Position", Value: 1}}}},
+	}
+
+	snapshots := []models.DepthChartSnapshot{}
+	cursor, err := r.snapshots.Aggregate(ctx, pipeline)
+	if err != nil {
+		log.WithError(err).Error("Failed to find depth chart snapshots")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &snapshots); err != nil {
+		log.WithError(err).Error("Failed to decode depth chart snapshots")
+		return nil, err
+	}
+
+	log.WithField("count", len(snapshots)).Info("Depth chart snapshots retrieved successfully")
+	return snapshots, nil
+}
+
+func (r *DepthChartsRepository) ListChanges(ctx context.Context, filter *DepthChartChangeFilter, opts *ListOptions) (*Page[models.DepthChartChange], error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "depth_charts_repository.ListChanges",
+		"team":      filter.Team,
+		"limit":     opts.Limit,
+		"offset":    opts.Offset,
+	})
+	log.Info("Listing depth chart changes")
+
+	page, err := findPage[models.DepthChartChange](ctx, r.changes, filter.bson(), opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to list depth chart changes")
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"count": len(page.Items),
+		"total": page.Total,
+	}).Info("Depth chart changes retrieved successfully")
+	return page, nil
+}
+
+func (r *DepthChartsRepository) BulkUpsertByTeamAndPosition(ctx context.Context, charts []models.DepthChart) (*BulkResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "depth_charts_repository.BulkUpsertByTeamAndPosition",
+		"count":     len(charts),
+	})
+	log.Info("Bulk upserting depth charts")
+
+	now := time.Now()
+	// A document whose LastUpdated is already set keeps it, so an unchanged document is written as a no-op
+	for i := range charts {
+		if charts[i].LastUpdated.IsZero() {
+			charts[i].LastUpdated = now
+		}
+	}
+
+	result, err := bulkUpsert(ctx, r.collection, charts, func(chart *models.DepthChart) bson.M {
+		return bson.M{"TeamID": chart.TeamID, "Position": chart.Position}
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to bulk upsert depth charts")
+		return result, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"inserted":  result.Inserted,
+		"modified":  result.Modified,
+		"unchanged": result.Unchanged,
+		"failed":    result.Failed,
+		"batches":   len(result.Batches),
+	}).Info("Depth charts bulk upserted")
+	return result, nil
+}
+
+func (r *DepthChartsRepository) InsertSnapshots(ctx context.Context, snapshots []models.DepthChartSnapshot, changes []models.DepthChartChange) error {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "depth_charts_repository.InsertSnapshots",
+		"snapshots": len(snapshots),
+		"changes":   len(changes),
+	})
+	log.Info("Inserting depth chart snapshots")
+
+	if len(snapshots) > 0 {
+		docs := make([]interface{}, 0, len(snapshots))
+		for i := range snapshots {
+			docs = append(docs, &snapshots[i])
+		}
+		if _, err := r.snapshots.InsertMany(ctx, docs); err != nil {
+			log.WithError(err).Error("Failed to insert depth chart snapshots")
+			return err
+		}
+	}
+
+	if len(changes) > 0 {
+		docs := make([]interface{}, 0, len(changes))
+		for i := range changes {
+			docs = append(docs, &changes[i])
+		}
+		if _, err := r.changes.InsertMany(ctx, docs); err != nil {
+			log.WithError(err).Error("Failed to insert depth chart changes")
+			return err
+		}
+	}
+
+	log.Info("Depth chart snapshots inserted successfully")
+	return nil
+}
@@ -0,0 +1,160 @@
+package repositories
+
+import (
+	"regexp"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+)
+
+// Sides of a game a team filter can be restricted to
+const (
+	SideHome = "home"
+	SideAway = "away"
+)
+
+// MatchFilter holds the criteria shared by games and schedules. Every set field is ANDed together.
+type MatchFilter struct {
+	Season     *int
+	SeasonType *int
+	WeekFrom   *int
+	WeekTo     *int
+	Team       string
+	// Side restricts Team to the home or away side; empty matches either
+	Side     string
+	Statuses []string
+	// DateFrom is inclusive, DateTo is exclusive
+	DateFrom     *time.Time
+	DateTo       *time.Time
+	StadiumID    *int
+	Channel      string
+	SpreadMin    *float64
+	SpreadMax    *float64
+	OverUnderMin *float64
+	OverUnderMax *float64
+}
+
+// GameFilter selects games for List
+type GameFilter struct {
+	MatchFilter
+	// Overtime only matches games that went to overtime
+	Overtime bool
+}
+
+// ScheduleFilter selects schedules for List
+type ScheduleFilter struct {
+	MatchFilter
+}
+
+func (f *MatchFilter) conditions() []bson.M {
+	var conds []bson.M
+
+	if f.Season != nil {
+		conds = append(conds, bson.M{"Season": *f.Season})
+	}
+	if f.SeasonType != nil {
+		conds = append(conds, bson.M{"SeasonType": *f.SeasonType})
+	}
+	if week := rangeCondition(f.WeekFrom, f.WeekTo); week != nil {
+		conds = append(conds, bson.M{"Week": week})
+	}
+
+	if f.Team != "" {
+		switch f.Side {
+		case SideHome:
+			conds = append(conds, bson.M{"HomeTeam": f.Team})
+		case SideAway:
+			conds = append(conds, bson.M{"AwayTeam": f.Team})
+		default:
+			conds = append(conds, bson.M{
+				"$or": []bson.M{
+					{"HomeTeam": f.Team},
+					{"AwayTeam": f.Team},
+				},
+			})
+		}
+	}
+
+	if len(f.Statuses) > 0 {
+		conds = append(conds, bson.M{"Status": bson.M{"$in": f.Statuses}})
+	}
+
+	if f.DateFrom != nil || f.DateTo != nil {
+		date := bson.M{}
+		if f.DateFrom != nil {
+			date["$gte"] = *f.DateFrom
+		}
+		if f.DateTo != nil {
+			date["$lt"] = *f.DateTo
+		}
+		conds = append(conds, bson.M{"Date": date})
+	}
+
+	if f.StadiumID != nil {
+		conds = append(conds, bson.M{"StadiumID": *f.StadiumID})
+	}
+	if f.Channel != "" {
+		conds = append(conds, bson.M{"Channel": equalFold(f.Channel)})
+	}
+	if spread := rangeCondition(f.SpreadMin, f.SpreadMax); spread != nil {
+		conds = append(conds, bson.M{"PointSpread": spread})
+	}
+	if overUnder := rangeCondition(f.OverUnderMin, f.OverUnderMax); overUnder != nil {
+		conds = append(conds, bson.M{"OverUnder": overUnder})
+	}
+
+	return conds
+}
+
+func (f *GameFilter) bson() bson.M {
+	conds := f.MatchFilter.conditions()
+
+	if f.Overtime {
+		conds = append(conds, bson.M{
+			"$or": []bson.M{
+				{"Status": models.GameStatusFinalOT},
+				{"HomeScoreOvertime": bson.M{"$gt": 0}},
+				{"AwayScoreOvertime": bson.M{"$gt": 0}},
+			},
+		})
+	}
+
+	return and(conds)
+}
+
+func (f *ScheduleFilter) bson() bson.M {
+	return and(f.MatchFilter.conditions())
+}
+
+// rangeCondition builds an inclusive range match, or nil when neither bound is set
+func rangeCondition[T int | float64](min *T, max *T) bson.M {
+	if min == nil && max == nil {
+		return nil
+	}
+	cond := bson.M{}
+	if min != nil {
+		cond["$gte"] = *min
+	}
+	if max != nil {
+		cond["$lte"] = *max
+	}
+	return cond
+}
+
+// equalFold matches a string field case-insensitively
+func equalFold(value string) primitive.Regex {
+	return primitive.Regex{Pattern: "^" + regexp.QuoteMeta(value) + "$", Options: "i"}
+}
+
+func and(conds []bson.M) bson.M {
+	switch len(conds) {
+	case 0:
+		return bson.M{}
+	case 1:
+		return conds[0]
+	}
+	return bson.M{"$and": conds}
+}
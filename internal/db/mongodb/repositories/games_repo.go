@@ -46,6 +46,27 @@ func (r *GamesRepository) FindAll(ctx context.Context) ([]models.Game, error) {
 	return games, nil
 }
 
+func (r *GamesRepository) List(ctx context.Context, filter *GameFilter, opts *ListOptions) (*Page[models.Game], error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "games_repository.List",
+		"limit":     opts.Limit,
+		"offset":    opts.Offset,
+	})
+	log.Info("Listing games")
+
+	page, err := findPage[models.Game](ctx, r.collection, filter.bson(), opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to list games")
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"count": len(page.Items),
+		"total": page.Total,
+	}).Info("Games retrieved successfully")
+	return page, nil
+}
+
 func (r *GamesRepository) FindByID(ctx context.Context, id string) (*models.Game, error) {
 	log := logger.WithRequestContext(ctx).WithField("component", "games_repository.FindByID").WithField("game_id", id)
 	log.Info("Finding game by ID")
@@ -88,6 +109,27 @@ func (r *GamesRepository) FindByGameKey(ctx context.Context, gameKey string) (*m
 	return &game, nil
 }
 
+func (r *GamesRepository) FindByGameKeys(ctx context.Context, gameKeys []string) ([]models.Game, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "games_repository.FindByGameKeys").WithField("count", len(gameKeys))
+	log.Info("Finding games by GameKeys")
+
+	var games []models.Game
+	cursor, err := r.collection.Find(ctx, bson.M{"GameKey": bson.M{"$in": gameKeys}})
+	if err != nil {
+		log.WithError(err).Error("Failed to find games by GameKeys")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &games); err != nil {
+		log.WithError(err).Error("Failed to decode games")
+		return nil, err
+	}
+
+	log.WithField("count", len(games)).Info("Games retrieved successfully")
+	return games, nil
+}
+
 func (r *GamesRepository) FindByTeam(ctx context.Context, team string) ([]models.Game, error) {
 	log := logger.WithRequestContext(ctx).WithField("component", "games_repository.FindByTeam").WithField("team", team)
 	log.Info("Finding games by team")
@@ -116,6 +158,84 @@ func (r *GamesRepository) FindByTeam(ctx context.Context, team string) ([]models
 	return games, nil
 }
 
+// FindMeetings returns the final regular season and postseason games between two teams in the given
+// seasons, newest first. No seasons means every season.
+func (r *GamesRepository) FindMeetings(ctx context.Context, teamA string, teamB string, seasons []int) ([]models.Game, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "games_repository.FindMeetings",
+		"team_a":    teamA,
+		"team_b":    teamB,
+		"seasons":   seasons,
+	})
+	log.Info("Finding games between teams")
+
+	opts := options.Find().SetSort(bson.D{{Key: "Date", Value: -1}})
+
+	games := []models.Game{}
+	cursor, err := r.collection.Find(ctx, meetingsFilter(teamA, teamB, seasons), opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to find games between teams")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &games); err != nil {
+		log.WithError(err).Error("Failed to decode games")
+		return nil, err
+	}
+
+	log.WithField("count", len(games)).Info("Games retrieved successfully")
+	return games, nil
+}
+
+// meetingsFilter matches the final regular season and postseason games between two teams in the given
+// seasons, or in every season when there are none
+func meetingsFilter(teamA string, teamB string, seasons []int) bson.M {
+	filter := bson.M{
+		"$or": []bson.M{
+			{"HomeTeam": teamA, "AwayTeam": teamB},
+			{"HomeTeam": teamB, "AwayTeam": teamA},
+		},
+		"SeasonType": bson.M{"$ne": 2},
+		"Status":     bson.M{"$in": []string{models.GameStatusFinal, models.GameStatusFinalOT}},
+	}
+	if len(seasons) > 0 {
+		filter["Season"] = bson.M{"$in": seasons}
+	}
+	return filter
+}
+
+// FindBySeason returns every game of a season type in a season
+func (r *GamesRepository) FindBySeason(ctx context.Context, season int, seasonType int) ([]models.Game, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component":   "games_repository.FindBySeason",
+		"season":      season,
+		"season_type": seasonType,
+	})
+	log.Info("Finding games by season")
+
+	filter := bson.M{
+		"Season":     season,
+		"SeasonType": seasonType,
+	}
+
+	games := []models.Game{}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		log.WithError(err).Error("Failed to find games by season")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &games); err != nil {
+		log.WithError(err).Error("Failed to decode games")
+		return nil, err
+	}
+
+	log.WithField("count", len(games)).Info("Games retrieved successfully")
+	return games, nil
+}
+
 func (r *GamesRepository) FindByWeek(ctx context.Context, season int, week int) ([]models.Game, error) {
 	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
 		"component": "games_repository.FindByWeek",
@@ -222,6 +342,39 @@ func (r *GamesRepository) UpsertByGameKey(ctx context.Context, game *models.Game
 	return &updatedGame, nil
 }
 
+func (r *GamesRepository) BulkUpsertByGameKey(ctx context.Context, games []models.Game) (*BulkResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "games_repository.BulkUpsertByGameKey",
+		"count":     len(games),
+	})
+	log.Info("Bulk upserting games")
+
+	now := time.Now()
+	// A document whose LastUpdated is already set keeps it, so an unchanged document is written as a no-op
+	for i := range games {
+		if games[i].LastUpdated.IsZero() {
+			games[i].LastUpdated = now
+		}
+	}
+
+	result, err := bulkUpsert(ctx, r.collection, games, func(game *models.Game) bson.M {
+		return bson.M{"GameKey": game.GameKey}
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to bulk upsert games")
+		return result, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"inserted":  result.Inserted,
+		"modified":  result.Modified,
+		"unchanged": result.Unchanged,
+		"failed":    result.Failed,
+		"batches":   len(result.Batches),
+	}).Info("Games bulk upserted")
+	return result, nil
+}
+
 func (r *GamesRepository) Delete(ctx context.Context, id string) error {
 	log := logger.WithRequestContext(ctx).WithField("component", "games_repository.Delete").WithField("game_id", id)
 	log.Info("Deleting game")
@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+)
+
+func TestMeetingsFilter(t *testing.T) {
+	meetings := func() bson.M {
+		return bson.M{
+			"$or": []bson.M{
+				{"HomeTeam": "BUF", "AwayTeam": "MIA"},
+				{"HomeTeam": "MIA", "AwayTeam": "BUF"},
+			},
+			"SeasonType": bson.M{"$ne": 2},
+			"Status":     bson.M{"$in": []string{models.GameStatusFinal, models.GameStatusFinalOT}},
+		}
+	}
+	inSeasons := func(seasons ...int) bson.M {
+		filter := meetings()
+		filter["Season"] = bson.M{"$in": seasons}
+		return filter
+	}
+
+	tests := []struct {
+		name    string
+		seasons []int
+		want    bson.M
+	}{
+		{name: "every season", want: meetings()},
+		{name: "one season", seasons: []int{2023}, want: inSeasons(2023)},
+		{name: "several seasons", seasons: []int{2019, 2021, 2022, 2023}, want: inSeasons(2019, 2021, 2022, 2023)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := meetingsFilter("BUF", "MIA", tt.seasons); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+type InjuriesRepository struct {
+	collection *mongo.Collection
+}
+
+func NewInjuriesRepository(client *mongo.Database) *InjuriesRepository {
+	return &InjuriesRepository{
+		collection: client.Collection("injuries"),
+	}
+}
+
+// InjuryFilter selects injuries for List. Every set field is ANDed together.
+type InjuryFilter struct {
+	PlayerID   *int
+	Team       string
+	Season     *int
+	SeasonType *int
+	WeekFrom   *int
+	WeekTo     *int
+	Statuses   []string
+}
+
+func (f *InjuryFilter) bson() bson.M {
+	var conds []bson.M
+
+	if f.PlayerID != nil {
+		conds = append(conds, bson.M{"PlayerID": *f.PlayerID})
+	}
+	if f.Team != "" {
+		conds = append(conds, bson.M{"Team": f.Team})
+	}
+	if f.Season != nil {
+		conds = append(conds, bson.M{"Season": *f.Season})
+	}
+	if f.SeasonType != nil {
+		conds = append(conds, bson.M{"SeasonType": *f.SeasonType})
+	}
+	if week := rangeCondition(f.WeekFrom, f.WeekTo); week != nil {
+		conds = append(conds, bson.M{"Week": week})
+	}
+	if len(f.Statuses) > 0 {
+		conds = append(conds, bson.M{"Status": bson.M{"$in": f.Statuses}})
+	}
+
+	return and(conds)
+}
+
+func (r *InjuriesRepository) List(ctx context.Context, filter *InjuryFilter, opts *ListOptions) (*Page[models.Injury], error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "injuries_repository.List",
+		"limit":     opts.Limit,
+		"offset":    opts.Offset,
+	})
+	log.Info("Listing injuries")
+
+	page, err := findPage[models.Injury](ctx, r.collection, filter.bson(), opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to list injuries")
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"count": len(page.Items),
+		"total": page.Total,
+	}).Info("Injuries retrieved successfully")
+	return page, nil
+}
+
+func (r *InjuriesRepository) FindByWeek(ctx context.Context, season int, seasonType int, week int) ([]models.Injury, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component":   "injuries_repository.FindByWeek",
+		"season":      season,
+		"season_type": seasonType,
+		"week":        week,
+	})
+	log.Info("Finding injuries by week")
+
+	filter := bson.M{
+		"Season":     season,
+		"SeasonType": seasonType,
+		"Week":       week,
+	}
+
+	var injuries []models.Injury
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		log.WithError(err).Error("Failed to find injuries by week")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &injuries); err != nil {
+		log.WithError(err).Error("Failed to decode injuries")
+		return nil, err
+	}
+
+	log.WithField("count", len(injuries)).Info("Injuries retrieved successfully")
+	return injuries, nil
+}
+
+func (r *InjuriesRepository) BulkUpsertByPlayerAndWeek(ctx context.Context, injuries []models.Injury) (*BulkResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "injuries_repository.BulkUpsertByPlayerAndWeek",
+		"count":     len(injuries),
+	})
+	log.Info("Bulk upserting injuries")
+
+	now := time.Now()
+	// A document whose LastUpdated is already set keeps it, so an unchanged document is written as a no-op
+	for i := range injuries {
+		if injuries[i].LastUpdated.IsZero() {
+			injuries[i].LastUpdated = now
+		}
+	}
+
+	result, err := bulkUpsert(ctx, r.collection, injuries, func(injury *models.Injury) bson.M {
+		return bson.M{
+			"PlayerID":   injury.PlayerID,
+			"Season":     injury.Season,
+			"SeasonType": injury.SeasonType,
+			"Week":       injury.Week,
+		}
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to bulk upsert injuries")
+		return result, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"inserted":  result.Inserted,
+		"modified":  result.Modified,
+		"unchanged": result.Unchanged,
+		"failed":    result.Failed,
+		"batches":   len(result.Batches),
+	}).Info("Injuries bulk upserted")
+	return result, nil
+}
@@ -0,0 +1,180 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// OddsRepository stores the betting line snapshots of games. Snapshots are only ever appended.
+type OddsRepository struct {
+	collection *mongo.Collection
+}
+
+func NewOddsRepository(client *mongo.Database) *OddsRepository {
+	return &OddsRepository{
+		collection: client.Collection("odds_snapshots"),
+	}
+}
+
+// OddsSnapshotFilter selects odds snapshots for List. Every set field is ANDed together.
+type OddsSnapshotFilter struct {
+	GameKey     string
+	Sportsbooks []string
+}
+
+func (f *OddsSnapshotFilter) bson() bson.M {
+	var conds []bson.M
+
+	if f.GameKey != "" {
+		conds = append(conds, bson.M{"GameKey": f.GameKey})
+	}
+	if len(f.Sportsbooks) > 0 {
+		conds = append(conds, bson.M{"Sportsbook": bson.M{"$in": f.Sportsbooks}})
+	}
+
+	return and(conds)
+}
+
+// List lists odds snapshots oldest first unless another sort is requested
+func (r *OddsRepository) List(ctx context.Context, filter *OddsSnapshotFilter, opts *ListOptions) (*Page[models.OddsSnapshot], error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "odds_repository.List",
+		"game_key":  filter.GameKey,
+		"limit":     opts.Limit,
+		"offset":    opts.Offset,
+	})
+	log.Info("Listing odds snapshots")
+
+	page, err := findPage[models.OddsSnapshot](ctx, r.collection, filter.bson(), withDefaultSort(opts, "TakenAt"))
+	if err != nil {
+		log.WithError(err).Error("Failed to list odds snapshots")
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"count": len(page.Items),
+		"total": page.Total,
+	}).Info("Odds snapshots retrieved successfully")
+	return page, nil
+}
+
+// FindLatestByGameKeys returns the latest snapshot of each of the games from the sportsbook
+func (r *OddsRepository) FindLatestByGameKeys(ctx context.Context, gameKeys []string, sportsbook string) ([]models.OddsSnapshot, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component":  "odds_repository.FindLatestByGameKeys",
+		"count":      len(gameKeys),
+		"sportsbook": sportsbook,
+	})
+	log.Info("Finding latest odds snapshots by game keys")
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"GameKey": bson.M{"$in": gameKeys}, "Sportsbook": sportsbook}}},
+		{{Key: "$sort", Value: bson.D{{Key: "TakenAt", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": "$GameKey",
+			"doc": bson.M{"$first": "$$ROOT"},
+		}}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$doc"}}},
+	}
+
+	snapshots := []models.OddsSnapshot{}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		log.WithError(err).Error("Failed to find latest odds snapshots")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &snapshots); err != nil {
+		log.WithError(err).Error("Failed to decode odds snapshots")
+		return nil, err
+	}
+
+	log.WithField("found", len(snapshots)).Info("Latest odds snapshots retrieved successfully")
+	return snapshots, nil
+}
+
+// FindSummariesByGameKeys returns the opening and closing line of each of the games from the sportsbook.
+// Games without snapshots have no summary.
+func (r *OddsRepository) FindSummariesByGameKeys(ctx context.Context, gameKeys []string, sportsbook string) ([]models.OddsSummary, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component":  "odds_repository.FindSummariesByGameKeys",
+		"count":      len(gameKeys),
+		"sportsbook": sportsbook,
+	})
+	log.Info("Finding odds summaries by game keys")
+
+	line := func(position string) bson.M {
+		return bson.M{position: bson.M{
+			"PointSpread":       "$PointSpread",
+			"OverUnder":         "$OverUnder",
+			"HomeTeamMoneyLine": "$HomeTeamMoneyLine",
+			"AwayTeamMoneyLine": "$AwayTeamMoneyLine",
+			"TakenAt":           "$TakenAt",
+		}}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"GameKey": bson.M{"$in": gameKeys}, "Sportsbook": sportsbook}}},
+		{{Key: "$sort", Value: bson.D{{Key: "TakenAt", Value: 1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":        "$GameKey",
+			"GameKey":    bson.M{"$first": "$GameKey"},
+			"Sportsbook": bson.M{"$first": "$Sportsbook"},
+			"Opening":    line("$first"),
+			"Closing":    line("$last"),
+			"Snapshots":  bson.M{"$sum": 1},
+		}}},
+	}
+
+	summaries := []models.OddsSummary{}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		log.WithError(err).Error("Failed to find odds summaries")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &summaries); err != nil {
+		log.WithError(err).Error("Failed to decode odds summaries")
+		return nil, err
+	}
+
+	for i := range summaries {
+		summaries[i].SpreadMovement = summaries[i].Closing.PointSpread - summaries[i].Opening.PointSpread
+		summaries[i].TotalMovement = summaries[i].Closing.OverUnder - summaries[i].Opening.OverUnder
+	}
+
+	log.WithField("found", len(summaries)).Info("Odds summaries retrieved successfully")
+	return summaries, nil
+}
+
+func (r *OddsRepository) InsertSnapshots(ctx context.Context, snapshots []models.OddsSnapshot) error {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "odds_repository.InsertSnapshots",
+		"count":     len(snapshots),
+	})
+	log.Info("Inserting odds snapshots")
+
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, 0, len(snapshots))
+	for i := range snapshots {
+		docs = append(docs, &snapshots[i])
+	}
+	if _, err := r.collection.InsertMany(ctx, docs); err != nil {
+		log.WithError(err).Error("Failed to insert odds snapshots")
+		return err
+	}
+
+	log.Info("Odds snapshots inserted successfully")
+	return nil
+}
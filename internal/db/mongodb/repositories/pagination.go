@@ -0,0 +1,224 @@
+package repositories
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+// ListOptions controls paging and ordering of list queries.
+// When Cursor is set it takes precedence over Offset.
+type ListOptions struct {
+	Limit  int64
+	Offset int64
+	Cursor string
+	// Sort uses BSON field names; _id is always appended as a tiebreaker
+	Sort bson.D
+}
+
+// Page is one page of a list query
+type Page[T any] struct {
+	Items      []T
+	Total      int64
+	NextCursor string
+}
+
+// pageCursor is the decoded form of an opaque cursor: the sort it was created for and the
+// sort key values of the last item on the previous page
+type pageCursor struct {
+	Sort   bson.D          `bson:"s"`
+	Values []bson.RawValue `bson:"v"`
+}
+
+// findPage runs a paginated find. It fetches one extra document to decide whether there is a next page.
+func findPage[T any](ctx context.Context, collection *mongo.Collection, filter bson.M, opts *ListOptions) (*Page[T], error) {
+	sort := withIDTiebreaker(opts.Sort)
+
+	total, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	query := filter
+	findOpts := options.Find().SetSort(sort).SetLimit(opts.Limit + 1)
+
+	if opts.Cursor != "" {
+		after, err := keysetFilter(opts.Cursor, sort)
+		if err != nil {
+			return nil, err
+		}
+		query = bson.M{"$and": []bson.M{filter, after}}
+	} else if opts.Offset > 0 {
+		findOpts.SetSkip(opts.Offset)
+	}
+
+	cursor, err := collection.Find(ctx, query, findOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	items := []T{}
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+
+	page := &Page[T]{Items: items, Total: total}
+	if int64(len(items)) > opts.Limit {
+		page.Items = items[:opts.Limit]
+		page.NextCursor, err = encodeCursor(page.Items[len(page.Items)-1], sort)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+// withIDTiebreaker appends _id to the sort so that every document has a unique position
+func withIDTiebreaker(sort bson.D) bson.D {
+	if hasSortKey(sort, "_id") {
+		return sort
+	}
+	return append(append(bson.D{}, sort...), bson.E{Key: "_id", Value: 1})
+}
+
+func hasSortKey(sort bson.D, key string) bool {
+	for _, e := range sort {
+		if e.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeCursor captures the sort key values of the last item of a page
+func encodeCursor(last interface{}, sort bson.D) (string, error) {
+	raw, err := bson.Marshal(last)
+	if err != nil {
+		return "", err
+	}
+
+	c := pageCursor{Sort: sort}
+	for _, e := range sort {
+		value, err := bson.Raw(raw).LookupErr(strings.Split(e.Key, ".")...)
+		if err != nil {
+			value = bson.RawValue{Type: bson.TypeNull}
+		}
+		c.Values = append(c.Values, value)
+	}
+
+	data, err := bson.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// keysetFilter matches the documents that sort after the cursor position:
+// (k1 > v1) OR (k1 = v1 AND k2 > v2) OR ... with > flipped to < for descending keys.
+// MongoDB sorts null and missing values before every other value but only compares values of the
+// same type, so "after null" and "after a value, descending" are spelled out.
+func keysetFilter(encoded string, sort bson.D) (bson.M, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c pageCursor
+	if err := bson.Unmarshal(data, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	// A cursor is only valid for the sort it was created with
+	if len(c.Values) != len(sort) || !reflect.DeepEqual(sortKeys(c.Sort), sortKeys(sort)) {
+		return nil, ErrInvalidCursor
+	}
+
+	or := []bson.M{}
+	for i, e := range sort {
+		clause := bson.M{}
+		for j := 0; j < i; j++ {
+			clause[sort[j].Key] = c.Values[j]
+		}
+		value := c.Values[i]
+		null := value.Type == bson.TypeNull || value.Type == bson.TypeUndefined
+		switch {
+		case !isDescending(e.Value) && null:
+			clause[e.Key] = bson.M{"$ne": nil}
+		case !isDescending(e.Value):
+			clause[e.Key] = bson.M{"$gt": value}
+		case null:
+			// Nothing sorts below null
+			continue
+		default:
+			clause["$or"] = []bson.M{{e.Key: bson.M{"$lt": value}}, {e.Key: nil}}
+		}
+		or = append(or, clause)
+	}
+
+	return bson.M{"$or": or}, nil
+}
+
+// sortKeys flattens a sort into comparable "key:direction" strings
+func sortKeys(sort bson.D) []string {
+	keys := make([]string, 0, len(sort))
+	for _, e := range sort {
+		dir := "asc"
+		if isDescending(e.Value) {
+			dir = "desc"
+		}
+		keys = append(keys, e.Key+":"+dir)
+	}
+	return keys
+}
+
+func isDescending(direction interface{}) bool {
+	switch d := direction.(type) {
+	case int:
+		return d < 0
+	case int32:
+		return d < 0
+	case int64:
+		return d < 0
+	}
+	return false
+}
+
+// FieldName maps a JSON field name of a model to its BSON field name, e.g. "homeTeam" to "HomeTeam" on models.Game
+func FieldName(model interface{}, jsonName string) (string, bool) {
+	t := reflect.TypeOf(model)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		// Fields of embedded structs such as models.TeamStats are stored inline
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			if bsonName, ok := FieldName(reflect.New(field.Type).Interface(), jsonName); ok {
+				return bsonName, true
+			}
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name != jsonName || name == "" || name == "-" {
+			continue
+		}
+		bsonName, _, _ := strings.Cut(field.Tag.Get("bson"), ",")
+		if bsonName == "" || bsonName == "-" {
+			return "", false
+		}
+		return bsonName, true
+	}
+
+	return "", false
+}
@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type pageDocument struct {
+	ID     primitive.ObjectID `bson:"_id"`
+	Season int                `bson:"Season"`
+	Team   struct {
+		Key string `bson:"Key"`
+	} `bson:"Team"`
+	Channel *string `bson:"Channel"`
+}
+
+// plain replaces the raw BSON values of a filter with their decoded values so that filters can be compared
+func plain(t *testing.T, value interface{}) interface{} {
+	switch v := value.(type) {
+	case bson.M:
+		m := bson.M{}
+		for key, inner := range v {
+			m[key] = plain(t, inner)
+		}
+		return m
+	case []bson.M:
+		list := make([]interface{}, len(v))
+		for i, inner := range v {
+			list[i] = plain(t, inner)
+		}
+		return list
+	case bson.RawValue:
+		var decoded interface{}
+		if err := v.Unmarshal(&decoded); err != nil {
+			t.Fatalf("decoding %v: %v", v, err)
+		}
+		return decoded
+	}
+	return value
+}
+
+func TestKeysetFilter(t *testing.T) {
+	id := primitive.NewObjectID()
+	last := pageDocument{ID: id, Season: 2023}
+	last.Team.Key = "BUF"
+
+	tests := []struct {
+		name string
+		sort bson.D
+		want []interface{}
+	}{
+		{
+			name: "ascending",
+			sort: bson.D{{Key: "Season", Value: 1}},
+			want: []interface{}{
+				bson.M{"Season": bson.M{"$gt": int32(2023)}},
+				bson.M{"Season": int32(2023), "_id": bson.M{"$gt": id}},
+			},
+		},
+		{
+			name: "descending nested key",
+			sort: bson.D{{Key: "Team.Key", Value: -1}, {Key: "Season", Value: 1}},
+			want: []interface{}{
+				bson.M{"$or": []interface{}{bson.M{"Team.Key": bson.M{"$lt": "BUF"}}, bson.M{"Team.Key": nil}}},
+				bson.M{"Team.Key": "BUF", "Season": bson.M{"$gt": int32(2023)}},
+				bson.M{"Team.Key": "BUF", "Season": int32(2023), "_id": bson.M{"$gt": id}},
+			},
+		},
+		{
+			name: "descending _id",
+			sort: bson.D{{Key: "_id", Value: int64(-1)}},
+			want: []interface{}{
+				bson.M{"$or": []interface{}{bson.M{"_id": bson.M{"$lt": id}}, bson.M{"_id": nil}}},
+			},
+		},
+		{
+			name: "null value",
+			sort: bson.D{{Key: "Channel", Value: 1}},
+			want: []interface{}{
+				bson.M{"Channel": bson.M{"$ne": nil}},
+				bson.M{"Channel": nil, "_id": bson.M{"$gt": id}},
+			},
+		},
+		{
+			name: "null value, descending",
+			sort: bson.D{{Key: "Channel", Value: -1}},
+			want: []interface{}{
+				bson.M{"Channel": nil, "_id": bson.M{"$gt": id}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sort := withIDTiebreaker(tt.sort)
+			cursor, err := encodeCursor(last, sort)
+			if err != nil {
+				t.Fatalf("encodeCursor: %v", err)
+			}
+
+			filter, err := keysetFilter(cursor, sort)
+			if err != nil {
+				t.Fatalf("keysetFilter: %v", err)
+			}
+			if got := plain(t, filter).(bson.M)["$or"]; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeysetFilterInvalidCursor(t *testing.T) {
+	sort := withIDTiebreaker(bson.D{{Key: "Season", Value: 1}})
+	cursor, err := encodeCursor(pageDocument{Season: 2023}, sort)
+	if err != nil {
+		t.Fatalf("encodeCursor: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		cursor string
+		sort   bson.D
+	}{
+		{name: "not base64", cursor: "not a cursor!", sort: sort},
+		{name: "not BSON", cursor: "bm90IGJzb24", sort: sort},
+		{name: "other key", cursor: cursor, sort: withIDTiebreaker(bson.D{{Key: "Week", Value: 1}})},
+		{name: "other direction", cursor: cursor, sort: withIDTiebreaker(bson.D{{Key: "Season", Value: -1}})},
+		{name: "more keys", cursor: cursor, sort: withIDTiebreaker(bson.D{{Key: "Season", Value: 1}, {Key: "Week", Value: 1}})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := keysetFilter(tt.cursor, tt.sort); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("got %v, want ErrInvalidCursor", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// PlayByPlayRepository stores the plays of games together with the drives derived from them
+type PlayByPlayRepository struct {
+	collection *mongo.Collection
+	drives     *mongo.Collection
+}
+
+func NewPlayByPlayRepository(client *mongo.Database) *PlayByPlayRepository {
+	return &PlayByPlayRepository{
+		collection: client.Collection("plays"),
+		drives:     client.Collection("drives"),
+	}
+}
+
+// PlayFilter selects plays for ListPlays. Every set field is ANDed together.
+type PlayFilter struct {
+	GameKey  string
+	Quarters []string
+	Team     string
+	Types    []string
+}
+
+func (f *PlayFilter) bson() bson.M {
+	var conds []bson.M
+
+	if f.GameKey != "" {
+		conds = append(conds, bson.M{"GameKey": f.GameKey})
+	}
+	if len(f.Quarters) > 0 {
+		conds = append(conds, bson.M{"Quarter": bson.M{"$in": f.Quarters}})
+	}
+	if f.Team != "" {
+		conds = append(conds, bson.M{"Team": f.Team})
+	}
+	if len(f.Types) > 0 {
+		conds = append(conds, bson.M{"Type": bson.M{"$in": f.Types}})
+	}
+
+	return and(conds)
+}
+
+// DriveFilter selects drives for ListDrives. Every set field is ANDed together.
+type DriveFilter struct {
+	GameKey string
+	// Quarters matches drives that started in one of the quarters
+	Quarters []string
+	Team     string
+	Results  []string
+}
+
+func (f *DriveFilter) bson() bson.M {
+	var conds []bson.M
+
+	if f.GameKey != "" {
+		conds = append(conds, bson.M{"GameKey": f.GameKey})
+	}
+	if len(f.Quarters) > 0 {
+		conds = append(conds, bson.M{"StartQuarter": bson.M{"$in": f.Quarters}})
+	}
+	if f.Team != "" {
+		conds = append(conds, bson.M{"Team": f.Team})
+	}
+	if len(f.Results) > 0 {
+		conds = append(conds, bson.M{"Result": bson.M{"$in": f.Results}})
+	}
+
+	return and(conds)
+}
+
+// withDefaultSort returns opts sorted by the given key when no sort was requested
+func withDefaultSort(opts *ListOptions, key string) *ListOptions {
+	if len(opts.Sort) > 0 {
+		return opts
+	}
+	sorted := *opts
+	sorted.Sort = bson.D{{Key: key, Value: 1}}
+	return &sorted
+}
+
+// ListPlays lists plays in game order unless another sort is requested
+func (r *PlayByPlayRepository) ListPlays(ctx context.Context, filter *PlayFilter, opts *ListOptions) (*Page[models.Play], error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "play_by_play_repository.ListPlays",
+		"game_key":  filter.GameKey,
+		"limit":     opts.Limit,
+		"offset":    opts.Offset,
+	})
+	log.Info("Listing plays")
+
+	page, err := findPage[models.Play](ctx, r.collection, filter.bson(), withDefaultSort(opts, "Sequence"))
+	if err != nil {
+		log.WithError(err).Error("Failed to list plays")
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"count": len(page.Items),
+		"total": page.Total,
+	}).Info("Plays retrieved successfully")
+	return page, nil
+}
+
+// ListDrives lists drives in game order unless another sort is requested
+func (r *PlayByPlayRepository) ListDrives(ctx context.Context, filter *DriveFilter, opts *ListOptions) (*Page[models.Drive], error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "play_by_play_repository.ListDrives",
+		"game_key":  filter.GameKey,
+		"limit":     opts.Limit,
+		"offset":    opts.Offset,
+	})
+	log.Info("Listing drives")
+
+	page, err := findPage[models.Drive](ctx, r.drives, filter.bson(), withDefaultSort(opts, "Number"))
+	if err != nil {
+		log.WithError(err).Error("Failed to list drives")
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"count": len(page.Items),
+		"total": page.Total,
+	}).Info("Drives retrieved successfully")
+	return page, nil
+}
+
+func (r *PlayByPlayRepository) FindPlaysByGameKey(ctx context.Context, gameKey string) ([]models.Play, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "play_by_play_repository.FindPlaysByGameKey").WithField("game_key", gameKey)
+	log.Info("Finding plays by game key")
+
+	opts := options.Find().SetSort(bson.D{{Key: "Sequence", Value: 1}})
+
+	var plays []models.Play
+	cursor, err := r.collection.Find(ctx, bson.M{"GameKey": gameKey}, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to find plays by game key")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &plays); err != nil {
+		log.WithError(err).Error("Failed to decode plays")
+		return nil, err
+	}
+
+	log.WithField("count", len(plays)).Info("Plays retrieved successfully")
+	return plays, nil
+}
+
+func (r *PlayByPlayRepository) BulkUpsertPlaysByPlayID(ctx context.Context, plays []models.Play) (*BulkResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "play_by_play_repository.BulkUpsertPlaysByPlayID",
+		"count":     len(plays),
+	})
+	log.Info("Bulk upserting plays")
+
+	now := time.Now()
+	// A document whose LastUpdated is already set keeps it, so an unchanged document is written as a no-op
+	for i := range plays {
+		if plays[i].LastUpdated.IsZero() {
+			plays[i].LastUpdated = now
+		}
+	}
+
+	result, err := bulkUpsert(ctx, r.collection, plays, func(play *models.Play) bson.M {
+		return bson.M{"PlayID": play.PlayID}
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to bulk upsert plays")
+		return result, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"inserted":  result.Inserted,
+		"modified":  result.Modified,
+		"unchanged": result.Unchanged,
+		"failed":    result.Failed,
+		"batches":   len(result.Batches),
+	}).Info("Plays bulk upserted")
+	return result, nil
+}
+
+// ReplaceDrives replaces the stored drives of a game. Drives are derived from the plays, so a correction
+// to the plays can change every drive of the game.
+func (r *PlayByPlayRepository) ReplaceDrives(ctx context.Context, gameKey string, drives []models.Drive) error {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "play_by_play_repository.ReplaceDrives",
+		"game_key":  gameKey,
+		"count":     len(drives),
+	})
+	log.Info("Replacing drives")
+
+	if _, err := r.drives.DeleteMany(ctx, bson.M{"GameKey": gameKey}); err != nil {
+		log.WithError(err).Error("Failed to delete drives")
+		return err
+	}
+
+	if len(drives) > 0 {
+		docs := make([]interface{}, 0, len(drives))
+		for i := range drives {
+			docs = append(docs, &drives[i])
+		}
+		if _, err := r.drives.InsertMany(ctx, docs); err != nil {
+			log.WithError(err).Error("Failed to insert drives")
+			return err
+		}
+	}
+
+	log.Info("Drives replaced successfully")
+	return nil
+}
@@ -0,0 +1,141 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+type PlayerGameStatsRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPlayerGameStatsRepository(client *mongo.Database) *PlayerGameStatsRepository {
+	return &PlayerGameStatsRepository{
+		collection: client.Collection("player_game_stats"),
+	}
+}
+
+// PlayerGameStatsFilter selects player game stats for List. Every set field is ANDed together.
+type PlayerGameStatsFilter struct {
+	PlayerID   *int
+	GameKey    string
+	Season     *int
+	SeasonType *int
+	WeekFrom   *int
+	WeekTo     *int
+	Team       string
+	Positions  []string
+}
+
+func (f *PlayerGameStatsFilter) bson() bson.M {
+	var conds []bson.M
+
+	if f.PlayerID != nil {
+		conds = append(conds, bson.M{"PlayerID": *f.PlayerID})
+	}
+	if f.GameKey != "" {
+		conds = append(conds, bson.M{"GameKey": f.GameKey})
+	}
+	if f.Season != nil {
+		conds = append(conds, bson.M{"Season": *f.Season})
+	}
+	if f.SeasonType != nil {
+		conds = append(conds, bson.M{"SeasonType": *f.SeasonType})
+	}
+	if week := rangeCondition(f.WeekFrom, f.WeekTo); week != nil {
+		conds = append(conds, bson.M{"Week": week})
+	}
+	if f.Team != "" {
+		conds = append(conds, bson.M{"Team": f.Team})
+	}
+	if len(f.Positions) > 0 {
+		conds = append(conds, bson.M{"Position": bson.M{"$in": f.Positions}})
+	}
+
+	return and(conds)
+}
+
+func (r *PlayerGameStatsRepository) List(ctx context.Context, filter *PlayerGameStatsFilter, opts *ListOptions) (*Page[models.PlayerGameStats], error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "player_game_stats_repository.List",
+		"limit":     opts.Limit,
+		"offset":    opts.Offset,
+	})
+	log.Info("Listing player game stats")
+
+	page, err := findPage[models.PlayerGameStats](ctx, r.collection, filter.bson(), opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to list player game stats")
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"count": len(page.Items),
+		"total": page.Total,
+	}).Info("Player game stats retrieved successfully")
+	return page, nil
+}
+
+func (r *PlayerGameStatsRepository) FindByGameKeys(ctx context.Context, gameKeys []string) ([]models.PlayerGameStats, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "player_game_stats_repository.FindByGameKeys").WithField("count", len(gameKeys))
+	log.Info("Finding player game stats by GameKeys")
+
+	var stats []models.PlayerGameStats
+	cursor, err := r.collection.Find(ctx, bson.M{"GameKey": bson.M{"$in": gameKeys}})
+	if err != nil {
+		log.WithError(err).Error("Failed to find player game stats by GameKeys")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &stats); err != nil {
+		log.WithError(err).Error("Failed to decode player game stats")
+		return nil, err
+	}
+
+	log.WithField("count", len(stats)).Info("Player game stats retrieved successfully")
+	return stats, nil
+}
+
+// playerGameStatsKey matches the stored stats of the same player in the same game
+func playerGameStatsKey(s *models.PlayerGameStats) bson.M {
+	return bson.M{"PlayerID": s.PlayerID, "GameKey": s.GameKey}
+}
+
+func (r *PlayerGameStatsRepository) BulkUpsertByPlayerAndGame(ctx context.Context, stats []models.PlayerGameStats) (*BulkResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "player_game_stats_repository.BulkUpsertByPlayerAndGame",
+		"count":     len(stats),
+	})
+	log.Info("Bulk upserting player game stats")
+
+	now := time.Now()
+	// A document whose LastUpdated is already set keeps it, so an unchanged document is written as a no-op
+	for i := range stats {
+		if stats[i].LastUpdated.IsZero() {
+			stats[i].LastUpdated = now
+		}
+	}
+
+	result, err := bulkUpsert(ctx, r.collection, stats, playerGameStatsKey)
+	if err != nil {
+		log.WithError(err).Error("Failed to bulk upsert player game stats")
+		return result, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"inserted":  result.Inserted,
+		"modified":  result.Modified,
+		"unchanged": result.Unchanged,
+		"failed":    result.Failed,
+		"batches":   len(result.Batches),
+	}).Info("Player game stats bulk upserted")
+	return result, nil
+}
@@ -0,0 +1,110 @@
+package repositories
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+)
+
+func intPtr(value int) *int {
+	return &value
+}
+
+func TestPlayerGameStatsFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter PlayerGameStatsFilter
+		want   bson.M
+	}{
+		{
+			name:   "empty",
+			filter: PlayerGameStatsFilter{},
+			want:   bson.M{},
+		},
+		{
+			name:   "player",
+			filter: PlayerGameStatsFilter{PlayerID: intPtr(19801)},
+			want:   bson.M{"PlayerID": 19801},
+		},
+		{
+			name:   "game",
+			filter: PlayerGameStatsFilter{GameKey: "202310105"},
+			want:   bson.M{"GameKey": "202310105"},
+		},
+		{
+			name:   "week range",
+			filter: PlayerGameStatsFilter{WeekFrom: intPtr(3), WeekTo: intPtr(8)},
+			want:   bson.M{"Week": bson.M{"$gte": 3, "$lte": 8}},
+		},
+		{
+			name:   "open week range",
+			filter: PlayerGameStatsFilter{WeekFrom: intPtr(10)},
+			want:   bson.M{"Week": bson.M{"$gte": 10}},
+		},
+		{
+			name:   "positions",
+			filter: PlayerGameStatsFilter{Positions: []string{"QB", "RB"}},
+			want:   bson.M{"Position": bson.M{"$in": []string{"QB", "RB"}}},
+		},
+		{
+			name: "every field",
+			filter: PlayerGameStatsFilter{
+				PlayerID:   intPtr(19801),
+				GameKey:    "202310105",
+				Season:     intPtr(2023),
+				SeasonType: intPtr(1),
+				WeekFrom:   intPtr(5),
+				WeekTo:     intPtr(5),
+				Team:       "BUF",
+				Positions:  []string{"QB"},
+			},
+			want: bson.M{"$and": []bson.M{
+				{"PlayerID": 19801},
+				{"GameKey": "202310105"},
+				{"Season": 2023},
+				{"SeasonType": 1},
+				{"Week": bson.M{"$gte": 5, "$lte": 5}},
+				{"Team": "BUF"},
+				{"Position": bson.M{"$in": []string{"QB"}}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.bson(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlayerGameStatsKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats models.PlayerGameStats
+		want  bson.M
+	}{
+		{
+			name:  "player and game",
+			stats: models.PlayerGameStats{PlayerID: 19801, GameKey: "202310105", Team: "BUF", Week: 1},
+			want:  bson.M{"PlayerID": 19801, "GameKey": "202310105"},
+		},
+		{
+			name:  "traded player keeps one document per game",
+			stats: models.PlayerGameStats{PlayerID: 19801, GameKey: "202310105", Team: "MIA", Week: 1},
+			want:  bson.M{"PlayerID": 19801, "GameKey": "202310105"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := playerGameStatsKey(&tt.stats); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,8 +3,11 @@ package repositories
 import (
 	"context"
 	"errors"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -45,6 +48,207 @@ func (r *PlayersRepository) FindAll(ctx context.Context) ([]models.Player, error
 	return players, nil
 }
 
+// PlayerFilter selects players for List; zero values are ignored
+type PlayerFilter struct {
+	Team string
+}
+
+func (f *PlayerFilter) bson() bson.M {
+	filter := bson.M{}
+	if f.Team != "" {
+		filter["Team"] = f.Team
+	}
+	return filter
+}
+
+func (r *PlayersRepository) List(ctx context.Context, filter *PlayerFilter, opts *ListOptions) (*Page[models.Player], error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "players_repository.List",
+		"team":      filter.Team,
+		"limit":     opts.Limit,
+		"offset":    opts.Offset,
+	})
+	log.Info("Listing players")
+
+	page, err := findPage[models.Player](ctx, r.collection, filter.bson(), opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to list players")
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"count": len(page.Items),
+		"total": page.Total,
+	}).Info("Players retrieved successfully")
+	return page, nil
+}
+
+// prefixMatchScore is the relevance given to players that only match a search term by prefix,
+// below any full-word match from the player_name_text index
+const prefixMatchScore = 0.5
+
+// PlayerSearch selects players for Search. Query matches names case-insensitively by whole word or prefix;
+// the other fields are ANDed together and zero values are ignored.
+type PlayerSearch struct {
+	Query              string
+	Team               string
+	Positions          []string
+	PositionCategories []string
+	FantasyPositions   []string
+	Status             string
+	Active             *bool
+	College            string
+	ExperienceMin      *int
+	ExperienceMax      *int
+	AgeMin             *int
+	AgeMax             *int
+}
+
+func (s *PlayerSearch) bson() bson.M {
+	filter := bson.M{}
+
+	if s.Team != "" {
+		filter["Team"] = s.Team
+	}
+	if len(s.Positions) > 0 {
+		filter["Position"] = bson.M{"$in": s.Positions}
+	}
+	if len(s.PositionCategories) > 0 {
+		filter["PositionCategory"] = bson.M{"$in": s.PositionCategories}
+	}
+	if len(s.FantasyPositions) > 0 {
+		filter["FantasyPosition"] = bson.M{"$in": s.FantasyPositions}
+	}
+	if s.Status != "" {
+		filter["Status"] = equalFold(s.Status)
+	}
+	if s.Active != nil {
+		filter["Active"] = *s.Active
+	}
+	if s.College != "" {
+		filter["College"] = equalFold(s.College)
+	}
+	if experience := rangeCondition(s.ExperienceMin, s.ExperienceMax); experience != nil {
+		filter["Experience"] = experience
+	}
+	if age := rangeCondition(s.AgeMin, s.AgeMax); age != nil {
+		filter["Age"] = age
+	}
+
+	return filter
+}
+
+// prefixFilter matches players where every search term starts a word of the player's name
+func (s *PlayerSearch) prefixFilter() bson.M {
+	terms := strings.Fields(s.Query)
+	conds := make([]bson.M, 0, len(terms))
+	for _, term := range terms {
+		pattern := primitive.Regex{Pattern: `(^|[\s'-])` + regexp.QuoteMeta(term), Options: "i"}
+		conds = append(conds, bson.M{
+			"$or": []bson.M{
+				{"Name": pattern},
+				{"FirstName": pattern},
+				{"LastName": pattern},
+			},
+		})
+	}
+	return and(conds)
+}
+
+func (r *PlayersRepository) Search(ctx context.Context, search *PlayerSearch, opts *ListOptions) (*Page[models.Player], error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "players_repository.Search",
+		"query":     search.Query,
+		"limit":     opts.Limit,
+		"offset":    opts.Offset,
+	})
+	log.Info("Searching players")
+
+	// Without a query there is no relevance, so this is a plain filtered list
+	if strings.TrimSpace(search.Query) == "" {
+		page, err := findPage[models.Player](ctx, r.collection, search.bson(), opts)
+		if err != nil {
+			log.WithError(err).Error("Failed to search players")
+			return nil, err
+		}
+		log.WithField("total", page.Total).Info("Players search completed")
+		return page, nil
+	}
+
+	textMatch := search.bson()
+	textMatch["$text"] = bson.M{"$search": search.Query}
+	prefixMatch := and([]bson.M{search.bson(), search.prefixFilter()})
+
+	// Relevance comes first unless an explicit sort was requested, then name for a stable order
+	sort := append(bson.D{}, opts.Sort...)
+	for _, e := range (bson.D{{Key: "_score", Value: -1}, {Key: "Name", Value: 1}, {Key: "_id", Value: 1}}) {
+		if !hasSortKey(sort, e.Key) {
+			sort = append(sort, e)
+		}
+	}
+
+	// Whole-word matches come from the text index and prefix matches from a regex scan;
+	// a player matched by both keeps the higher score
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: textMatch}},
+		{{Key: "$addFields", Value: bson.M{"_score": bson.M{"$meta": "textScore"}}}},
+		{{Key: "$unionWith", Value: bson.M{
+			"coll": r.collection.Name(),
+			"pipeline": mongo.Pipeline{
+				{{Key: "$match", Value: prefixMatch}},
+				{{Key: "$addFields", Value: bson.M{"_score": prefixMatchScore}}},
+			},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_score", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": "$_id",
+			"doc": bson.M{"$first": "$$ROOT"},
+		}}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$doc"}}},
+		{{Key: "$sort", Value: sort}},
+		{{Key: "$facet", Value: bson.M{
+			"items": mongo.Pipeline{
+				{{Key: "$skip", Value: opts.Offset}},
+				{{Key: "$limit", Value: opts.Limit}},
+			},
+			"total": mongo.Pipeline{
+				{{Key: "$count", Value: "count"}},
+			},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		log.WithError(err).Error("Failed to search players")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		Items []models.Player `bson:"items"`
+		Total []struct {
+			Count int64 `bson:"count"`
+		} `bson:"total"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		log.WithError(err).Error("Failed to decode players")
+		return nil, err
+	}
+
+	page := &Page[models.Player]{Items: []models.Player{}}
+	if len(results) > 0 {
+		if results[0].Items != nil {
+			page.Items = results[0].Items
+		}
+		if len(results[0].Total) > 0 {
+			page.Total = results[0].Total[0].Count
+		}
+	}
+
+	log.WithField("total", page.Total).Info("Players search completed")
+	return page, nil
+}
+
 func (r *PlayersRepository) FindByID(ctx context.Context, id string) (*models.Player, error) {
 	log := logger.WithRequestContext(ctx).WithField("component", "players_repository.FindByID").WithField("player_id", id)
 	log.Info("Finding player by ID")
@@ -184,6 +388,39 @@ func (r *PlayersRepository) UpsertByPlayerID(ctx context.Context, player *models
 	return &updatedPlayer, nil
 }
 
+func (r *PlayersRepository) BulkUpsertByPlayerID(ctx context.Context, players []models.Player) (*BulkResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "players_repository.BulkUpsertByPlayerID",
+		"count":     len(players),
+	})
+	log.Info("Bulk upserting players")
+
+	now := time.Now()
+	// A document whose LastUpdated is already set keeps it, so an unchanged document is written as a no-op
+	for i := range players {
+		if players[i].LastUpdated.IsZero() {
+			players[i].LastUpdated = now
+		}
+	}
+
+	result, err := bulkUpsert(ctx, r.collection, players, func(player *models.Player) bson.M {
+		return bson.M{"PlayerID": player.PlayerID}
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to bulk upsert players")
+		return result, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"inserted":  result.Inserted,
+		"modified":  result.Modified,
+		"unchanged": result.Unchanged,
+		"failed":    result.Failed,
+		"batches":   len(result.Batches),
+	}).Info("Players bulk upserted")
+	return result, nil
+}
+
 func (r *PlayersRepository) Delete(ctx context.Context, id string) error {
 	log := logger.WithRequestContext(ctx).WithField("component", "players_repository.Delete").WithField("player_id", id)
 	log.Info("Deleting player")
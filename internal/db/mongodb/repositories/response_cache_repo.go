@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+type ResponseCacheRepository struct {
+	collection *mongo.Collection
+}
+
+func NewResponseCacheRepository(client *mongo.Database) *ResponseCacheRepository {
+	return &ResponseCacheRepository{
+		collection: client.Collection("sportsdata_cache"),
+	}
+}
+
+func (r *ResponseCacheRepository) FindByURL(ctx context.Context, url string) (*models.ResponseCache, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "response_cache_repository.FindByURL").WithField("url", url)
+	log.Debug("Finding cached response by URL")
+
+	var entry models.ResponseCache
+	if err := r.collection.FindOne(ctx, bson.M{"URL": url}).Decode(&entry); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			log.Debug("Cached response not found")
+			return nil, nil
+		}
+		log.WithError(err).Error("Failed to find cached response")
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+func (r *ResponseCacheRepository) UpsertByURL(ctx context.Context, entry *models.ResponseCache) error {
+	log := logger.WithRequestContext(ctx).WithField("component", "response_cache_repository.UpsertByURL").WithField("url", entry.URL)
+	log.Debug("Upserting cached response by URL")
+
+	entry.LastUpdated = time.Now()
+
+	opts := options.Replace().SetUpsert(true)
+	if _, err := r.collection.ReplaceOne(ctx, bson.M{"URL": entry.URL}, entry, opts); err != nil {
+		log.WithError(err).Error("Failed to upsert cached response")
+		return err
+	}
+
+	return nil
+}
@@ -47,6 +47,27 @@ func (r *SchedulesRepository) FindAll(ctx context.Context) ([]models.Schedule, e
 	return schedules, nil
 }
 
+func (r *SchedulesRepository) List(ctx context.Context, filter *ScheduleFilter, opts *ListOptions) (*Page[models.Schedule], error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "schedules_repository.List",
+		"limit":     opts.Limit,
+		"offset":    opts.Offset,
+	})
+	log.Info("Listing schedules")
+
+	page, err := findPage[models.Schedule](ctx, r.collection, filter.bson(), opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to list schedules")
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"count": len(page.Items),
+		"total": page.Total,
+	}).Info("Schedules retrieved successfully")
+	return page, nil
+}
+
 func (r *SchedulesRepository) FindByID(ctx context.Context, id string) (*models.Schedule, error) {
 	log := logger.WithRequestContext(ctx).WithField("component", "schedules_repository.FindByID").WithField("schedule_id", id)
 	log.Info("Finding schedule by ID")
@@ -89,6 +110,27 @@ func (r *SchedulesRepository) FindByGameKey(ctx context.Context, gameKey string)
 	return &schedule, nil
 }
 
+func (r *SchedulesRepository) FindByGameKeys(ctx context.Context, gameKeys []string) ([]models.Schedule, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "schedules_repository.FindByGameKeys").WithField("count", len(gameKeys))
+	log.Info("Finding schedules by GameKeys")
+
+	var schedules []models.Schedule
+	cursor, err := r.collection.Find(ctx, bson.M{"GameKey": bson.M{"$in": gameKeys}})
+	if err != nil {
+		log.WithError(err).Error("Failed to find schedules by GameKeys")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &schedules); err != nil {
+		log.WithError(err).Error("Failed to decode schedules")
+		return nil, err
+	}
+
+	log.WithField("count", len(schedules)).Info("Schedules retrieved successfully")
+	return schedules, nil
+}
+
 func (r *SchedulesRepository) FindByTeam(ctx context.Context, team string) ([]models.Schedule, error) {
 	log := logger.WithRequestContext(ctx).WithField("component", "schedules_repository.FindByTeam").WithField("team", team)
 	log.Info("Finding schedules by team")
@@ -117,6 +159,72 @@ func (r *SchedulesRepository) FindByTeam(ctx context.Context, team string) ([]mo
 	return schedules, nil
 }
 
+// FindNextMeeting returns the first game between two teams scheduled at or after the given time,
+// or nil if none is scheduled
+func (r *SchedulesRepository) FindNextMeeting(ctx context.Context, teamA string, teamB string, after time.Time) (*models.Schedule, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "schedules_repository.FindNextMeeting",
+		"team_a":    teamA,
+		"team_b":    teamB,
+	})
+	log.Info("Finding next scheduled game between teams")
+
+	filter := bson.M{
+		"$or": []bson.M{
+			{"HomeTeam": teamA, "AwayTeam": teamB},
+			{"HomeTeam": teamB, "AwayTeam": teamA},
+		},
+		"Status":   models.GameStatusScheduled,
+		"Canceled": false,
+		"Date":     bson.M{"$gte": after},
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "Date", Value: 1}})
+
+	var schedule models.Schedule
+	if err := r.collection.FindOne(ctx, filter, opts).Decode(&schedule); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			log.Info("No scheduled game between teams")
+			return nil, nil
+		}
+		log.WithError(err).Error("Failed to find next scheduled game")
+		return nil, err
+	}
+
+	log.WithField("game_key", schedule.GameKey).Info("Next scheduled game found")
+	return &schedule, nil
+}
+
+// FindBySeason returns every schedule of a season type in a season
+func (r *SchedulesRepository) FindBySeason(ctx context.Context, season int, seasonType int) ([]models.Schedule, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component":   "schedules_repository.FindBySeason",
+		"season":      season,
+		"season_type": seasonType,
+	})
+	log.Info("Finding schedules by season")
+
+	filter := bson.M{
+		"Season":     season,
+		"SeasonType": seasonType,
+	}
+
+	schedules := []models.Schedule{}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		log.WithError(err).Error("Failed to find schedules by season")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &schedules); err != nil {
+		log.WithError(err).Error("Failed to decode schedules")
+		return nil, err
+	}
+
+	log.WithField("count", len(schedules)).Info("Schedules retrieved successfully")
+	return schedules, nil
+}
+
 func (r *SchedulesRepository) FindByWeek(ctx context.Context, season int, week int) ([]models.Schedule, error) {
 	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
 		"component": "schedules_repository.FindByWeek",
@@ -147,6 +255,40 @@ func (r *SchedulesRepository) FindByWeek(ctx context.Context, season int, week i
 	return schedules, nil
 }
 
+func (r *SchedulesRepository) FindByDateRange(ctx context.Context, from time.Time, to time.Time) ([]models.Schedule, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "schedules_repository.FindByDateRange",
+		"from":      from,
+		"to":        to,
+	})
+	log.Info("Finding schedules by date range")
+
+	filter := bson.M{
+		"DateTime": bson.M{
+			"$gte": from,
+			"$lt":  to,
+		},
+		"Canceled": false,
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "DateTime", Value: 1}})
+
+	var schedules []models.Schedule
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to find schedules by date range")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &schedules); err != nil {
+		log.WithError(err).Error("Failed to decode schedules")
+		return nil, err
+	}
+
+	log.WithField("count", len(schedules)).Info("Schedules retrieved successfully")
+	return schedules, nil
+}
+
 func (r *SchedulesRepository) Create(ctx context.Context, schedule *models.Schedule) (*models.Schedule, error) {
 	log := logger.WithRequestContext(ctx).WithField("component", "schedules_repository.Create").WithField("game_key", schedule.GameKey)
 	log.Info("Creating new schedule")
@@ -223,6 +365,39 @@ func (r *SchedulesRepository) UpsertByGameKey(ctx context.Context, schedule *mod
 	return &updatedSchedule, nil
 }
 
+func (r *SchedulesRepository) BulkUpsertByGameKey(ctx context.Context, schedules []models.Schedule) (*BulkResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "schedules_repository.BulkUpsertByGameKey",
+		"count":     len(schedules),
+	})
+	log.Info("Bulk upserting schedules")
+
+	now := time.Now()
+	// A document whose LastUpdated is already set keeps it, so an unchanged document is written as a no-op
+	for i := range schedules {
+		if schedules[i].LastUpdated.IsZero() {
+			schedules[i].LastUpdated = now
+		}
+	}
+
+	result, err := bulkUpsert(ctx, r.collection, schedules, func(schedule *models.Schedule) bson.M {
+		return bson.M{"GameKey": schedule.GameKey}
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to bulk upsert schedules")
+		return result, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"inserted":  result.Inserted,
+		"modified":  result.Modified,
+		"unchanged": result.Unchanged,
+		"failed":    result.Failed,
+		"batches":   len(result.Batches),
+	}).Info("Schedules bulk upserted")
+	return result, nil
+}
+
 func (r *SchedulesRepository) Delete(ctx context.Context, id string) error {
 	log := logger.WithRequestContext(ctx).WithField("component", "schedules_repository.Delete").WithField("schedule_id", id)
 	log.Info("Deleting schedule")
@@ -0,0 +1,161 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+type StadiumsRepository struct {
+	collection *mongo.Collection
+}
+
+func NewStadiumsRepository(client *mongo.Database) *StadiumsRepository {
+	return &StadiumsRepository{
+		collection: client.Collection("stadiums"),
+	}
+}
+
+// StadiumFilter selects stadiums for List. Every set field is ANDed together.
+type StadiumFilter struct {
+	State           string
+	PlayingSurfaces []string
+	Types           []string
+}
+
+func (f *StadiumFilter) bson() bson.M {
+	var conds []bson.M
+
+	if f.State != "" {
+		conds = append(conds, bson.M{"State": equalFold(f.State)})
+	}
+	if len(f.PlayingSurfaces) > 0 {
+		conds = append(conds, bson.M{"PlayingSurface": bson.M{"$in": f.PlayingSurfaces}})
+	}
+	if len(f.Types) > 0 {
+		conds = append(conds, bson.M{"Type": bson.M{"$in": f.Types}})
+	}
+
+	return and(conds)
+}
+
+func (r *StadiumsRepository) FindAll(ctx context.Context) ([]models.Stadium, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "stadiums_repository.FindAll")
+	log.Info("Fetching all stadiums")
+
+	var stadiums []models.Stadium
+	cursor, err := r.collection.Find(ctx, bson.M{})
+	if err != nil {
+		log.WithError(err).Error("Failed to find stadiums")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &stadiums); err != nil {
+		log.WithError(err).Error("Failed to decode stadiums")
+		return nil, err
+	}
+
+	log.WithField("count", len(stadiums)).Info("Stadiums retrieved successfully")
+	return stadiums, nil
+}
+
+func (r *StadiumsRepository) List(ctx context.Context, filter *StadiumFilter, opts *ListOptions) (*Page[models.Stadium], error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "stadiums_repository.List",
+		"limit":     opts.Limit,
+		"offset":    opts.Offset,
+	})
+	log.Info("Listing stadiums")
+
+	page, err := findPage[models.Stadium](ctx, r.collection, filter.bson(), opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to list stadiums")
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"count": len(page.Items),
+		"total": page.Total,
+	}).Info("Stadiums retrieved successfully")
+	return page, nil
+}
+
+func (r *StadiumsRepository) FindByStadiumID(ctx context.Context, stadiumID int) (*models.Stadium, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "stadiums_repository.FindByStadiumID").WithField("stadium_id", stadiumID)
+	log.Info("Finding stadium by StadiumID")
+
+	var stadium models.Stadium
+	if err := r.collection.FindOne(ctx, bson.M{"StadiumID": stadiumID}).Decode(&stadium); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			log.Info("Stadium not found")
+			return nil, nil
+		}
+		log.WithError(err).Error("Failed to find stadium")
+		return nil, err
+	}
+
+	log.Info("Stadium retrieved successfully")
+	return &stadium, nil
+}
+
+func (r *StadiumsRepository) FindByStadiumIDs(ctx context.Context, stadiumIDs []int) ([]models.Stadium, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "stadiums_repository.FindByStadiumIDs").WithField("count", len(stadiumIDs))
+	log.Info("Finding stadiums by StadiumIDs")
+
+	var stadiums []models.Stadium
+	cursor, err := r.collection.Find(ctx, bson.M{"StadiumID": bson.M{"$in": stadiumIDs}})
+	if err != nil {
+		log.WithError(err).Error("Failed to find stadiums by StadiumIDs")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &stadiums); err != nil {
+		log.WithError(err).Error("Failed to decode stadiums")
+		return nil, err
+	}
+
+	log.WithField("found", len(stadiums)).Info("Stadiums retrieved successfully")
+	return stadiums, nil
+}
+
+func (r *StadiumsRepository) BulkUpsertByStadiumID(ctx context.Context, stadiums []models.Stadium) (*BulkResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "stadiums_repository.BulkUpsertByStadiumID",
+		"count":     len(stadiums),
+	})
+	log.Info("Bulk upserting stadiums")
+
+	now := time.Now()
+	// A document whose LastUpdated is already set keeps it, so an unchanged document is written as a no-op
+	for i := range stadiums {
+		if stadiums[i].LastUpdated.IsZero() {
+			stadiums[i].LastUpdated = now
+		}
+	}
+
+	result, err := bulkUpsert(ctx, r.collection, stadiums, func(stadium *models.Stadium) bson.M {
+		return bson.M{"StadiumID": stadium.StadiumID}
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to bulk upsert stadiums")
+		return result, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"inserted":  result.Inserted,
+		"modified":  result.Modified,
+		"unchanged": result.Unchanged,
+		"failed":    result.Failed,
+		"batches":   len(result.Batches),
+	}).Info("Stadiums bulk upserted")
+	return result, nil
+}
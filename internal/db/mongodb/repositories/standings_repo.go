@@ -46,6 +46,45 @@ func (r *StandingsRepository) FindAll(ctx context.Context) ([]models.Standing, e
 	return standings, nil
 }
 
+// StandingFilter selects standings for List. Conference and division only apply when both are set.
+type StandingFilter struct {
+	Conference string
+	Division   string
+}
+
+func (f *StandingFilter) bson() bson.M {
+	if f.Conference != "" && f.Division != "" {
+		return bson.M{
+			"Conference": f.Conference,
+			"Division":   f.Division,
+		}
+	}
+	return bson.M{}
+}
+
+func (r *StandingsRepository) List(ctx context.Context, filter *StandingFilter, opts *ListOptions) (*Page[models.Standing], error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component":  "standings_repository.List",
+		"conference": filter.Conference,
+		"division":   filter.Division,
+		"limit":      opts.Limit,
+		"offset":     opts.Offset,
+	})
+	log.Info("Listing standings")
+
+	page, err := findPage[models.Standing](ctx, r.collection, filter.bson(), opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to list standings")
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"count": len(page.Items),
+		"total": page.Total,
+	}).Info("Standings retrieved successfully")
+	return page, nil
+}
+
 func (r *StandingsRepository) FindByID(ctx context.Context, id string) (*models.Standing, error) {
 	log := logger.WithRequestContext(ctx).WithField("component", "standings_repository.FindByID").WithField("standing_id", id)
 	log.Info("Finding standing by ID")
@@ -201,6 +240,42 @@ func (r *StandingsRepository) UpsertByTeamAndSeason(ctx context.Context, standin
 	return &updatedStanding, nil
 }
 
+func (r *StandingsRepository) BulkUpsertByTeamAndSeason(ctx context.Context, standings []models.Standing) (*BulkResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "standings_repository.BulkUpsertByTeamAndSeason",
+		"count":     len(standings),
+	})
+	log.Info("Bulk upserting standings")
+
+	now := time.Now()
+	// A document whose LastUpdated is already set keeps it, so an unchanged document is written as a no-op
+	for i := range standings {
+		if standings[i].LastUpdated.IsZero() {
+			standings[i].LastUpdated = now
+		}
+	}
+
+	result, err := bulkUpsert(ctx, r.collection, standings, func(standing *models.Standing) bson.M {
+		return bson.M{
+			"Team":   standing.Team,
+			"Season": standing.Season,
+		}
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to bulk upsert standings")
+		return result, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"inserted":  result.Inserted,
+		"modified":  result.Modified,
+		"unchanged": result.Unchanged,
+		"failed":    result.Failed,
+		"batches":   len(result.Batches),
+	}).Info("Standings bulk upserted")
+	return result, nil
+}
+
 func (r *StandingsRepository) Delete(ctx context.Context, id string) error {
 	log := logger.WithRequestContext(ctx).WithField("component", "standings_repository.Delete").WithField("standing_id", id)
 	log.Info("Deleting standing")
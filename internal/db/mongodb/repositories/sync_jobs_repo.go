@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+type SyncJobsRepository struct {
+	collection *mongo.Collection
+}
+
+func NewSyncJobsRepository(client *mongo.Database) *SyncJobsRepository {
+	return &SyncJobsRepository{
+		collection: client.Collection("sync_jobs"),
+	}
+}
+
+func (r *SyncJobsRepository) FindRecent(ctx context.Context, limit int64) ([]models.SyncJob, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "sync_jobs_repository.FindRecent").WithField("limit", limit)
+	log.Info("Fetching recent sync jobs")
+
+	opts := options.Find().SetSort(bson.D{{Key: "StartedAt", Value: -1}}).SetLimit(limit)
+
+	jobs := []models.SyncJob{}
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to find sync jobs")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &jobs); err != nil {
+		log.WithError(err).Error("Failed to decode sync jobs")
+		return nil, err
+	}
+
+	log.WithField("count", len(jobs)).Info("Sync jobs retrieved successfully")
+	return jobs, nil
+}
+
+func (r *SyncJobsRepository) FindByJobID(ctx context.Context, jobID string) (*models.SyncJob, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "sync_jobs_repository.FindByJobID").WithField("job_id", jobID)
+	log.Info("Finding sync job by JobID")
+
+	var job models.SyncJob
+	if err := r.collection.FindOne(ctx, bson.M{"JobID": jobID}).Decode(&job); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			log.Info("Sync job not found")
+			return nil, nil
+		}
+		log.WithError(err).Error("Failed to find sync job")
+		return nil, err
+	}
+
+	log.Info("Sync job found")
+	return &job, nil
+}
+
+func (r *SyncJobsRepository) Create(ctx context.Context, job *models.SyncJob) (*models.SyncJob, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "sync_jobs_repository.Create").WithField("job_id", job.JobID)
+	log.Info("Creating new sync job")
+
+	job.LastUpdated = time.Now()
+
+	result, err := r.collection.InsertOne(ctx, job)
+	if err != nil {
+		log.WithError(err).Error("Failed to create sync job")
+		return nil, err
+	}
+
+	job.ID = result.InsertedID.(primitive.ObjectID)
+
+	log.WithField("sync_job_id", job.ID.Hex()).Info("Sync job created successfully")
+	return job, nil
+}
+
+func (r *SyncJobsRepository) Update(ctx context.Context, job *models.SyncJob) (*models.SyncJob, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "sync_jobs_repository.Update").WithField("job_id", job.JobID)
+	log.Info("Updating sync job")
+
+	job.LastUpdated = time.Now()
+
+	result, err := r.collection.ReplaceOne(
+		ctx,
+		bson.M{"JobID": job.JobID},
+		job,
+	)
+	if err != nil {
+		log.WithError(err).Error("Failed to update sync job")
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		log.Warn("No sync job found with given JobID")
+		return nil, mongo.ErrNoDocuments
+	}
+
+	log.Info("Sync job updated successfully")
+	return job, nil
+}
@@ -0,0 +1,127 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+type TeamGameStatsRepository struct {
+	collection *mongo.Collection
+}
+
+func NewTeamGameStatsRepository(client *mongo.Database) *TeamGameStatsRepository {
+	return &TeamGameStatsRepository{
+		collection: client.Collection("team_game_stats"),
+	}
+}
+
+// TeamGameStatsFilter selects team game stats for List. Every set field is ANDed together.
+type TeamGameStatsFilter struct {
+	Team       string
+	Season     *int
+	SeasonType *int
+	WeekFrom   *int
+	WeekTo     *int
+}
+
+func (f *TeamGameStatsFilter) bson() bson.M {
+	var conds []bson.M
+
+	if f.Team != "" {
+		conds = append(conds, bson.M{"Team": f.Team})
+	}
+	if f.Season != nil {
+		conds = append(conds, bson.M{"Season": *f.Season})
+	}
+	if f.SeasonType != nil {
+		conds = append(conds, bson.M{"SeasonType": *f.SeasonType})
+	}
+	if week := rangeCondition(f.WeekFrom, f.WeekTo); week != nil {
+		conds = append(conds, bson.M{"Week": week})
+	}
+
+	return and(conds)
+}
+
+func (r *TeamGameStatsRepository) List(ctx context.Context, filter *TeamGameStatsFilter, opts *ListOptions) (*Page[models.TeamGameStats], error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "team_game_stats_repository.List",
+		"team":      filter.Team,
+		"limit":     opts.Limit,
+		"offset":    opts.Offset,
+	})
+	log.Info("Listing team game stats")
+
+	page, err := findPage[models.TeamGameStats](ctx, r.collection, filter.bson(), opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to list team game stats")
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"count": len(page.Items),
+		"total": page.Total,
+	}).Info("Team game stats retrieved successfully")
+	return page, nil
+}
+
+func (r *TeamGameStatsRepository) FindByGameKeys(ctx context.Context, gameKeys []string) ([]models.TeamGameStats, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "team_game_stats_repository.FindByGameKeys").WithField("count", len(gameKeys))
+	log.Info("Finding team game stats by GameKeys")
+
+	var stats []models.TeamGameStats
+	cursor, err := r.collection.Find(ctx, bson.M{"GameKey": bson.M{"$in": gameKeys}})
+	if err != nil {
+		log.WithError(err).Error("Failed to find team game stats by GameKeys")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &stats); err != nil {
+		log.WithError(err).Error("Failed to decode team game stats")
+		return nil, err
+	}
+
+	log.WithField("count", len(stats)).Info("Team game stats retrieved successfully")
+	return stats, nil
+}
+
+func (r *TeamGameStatsRepository) BulkUpsertByTeamAndGame(ctx context.Context, stats []models.TeamGameStats) (*BulkResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "team_game_stats_repository.BulkUpsertByTeamAndGame",
+		"count":     len(stats),
+	})
+	log.Info("Bulk upserting team game stats")
+
+	now := time.Now()
+	// A document whose LastUpdated is already set keeps it, so an unchanged document is written as a no-op
+	for i := range stats {
+		if stats[i].LastUpdated.IsZero() {
+			stats[i].LastUpdated = now
+		}
+	}
+
+	result, err := bulkUpsert(ctx, r.collection, stats, func(s *models.TeamGameStats) bson.M {
+		return bson.M{"Team": s.Team, "GameKey": s.GameKey}
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to bulk upsert team game stats")
+		return result, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"inserted":  result.Inserted,
+		"modified":  result.Modified,
+		"unchanged": result.Unchanged,
+		"failed":    result.Failed,
+		"batches":   len(result.Batches),
+	}).Info("Team game stats bulk upserted")
+	return result, nil
+}
@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+type TeamSeasonStatsRepository struct {
+	collection *mongo.Collection
+}
+
+func NewTeamSeasonStatsRepository(client *mongo.Database) *TeamSeasonStatsRepository {
+	return &TeamSeasonStatsRepository{
+		collection: client.Collection("team_season_stats"),
+	}
+}
+
+// TeamSeasonStatsFilter selects team season stats for List. Every set field is ANDed together.
+type TeamSeasonStatsFilter struct {
+	Team       string
+	Season     *int
+	SeasonType *int
+}
+
+func (f *TeamSeasonStatsFilter) bson() bson.M {
+	var conds []bson.M
+
+	if f.Team != "" {
+		conds = append(conds, bson.M{"Team": f.Team})
+	}
+	if f.Season != nil {
+		conds = append(conds, bson.M{"Season": *f.Season})
+	}
+	if f.SeasonType != nil {
+		conds = append(conds, bson.M{"SeasonType": *f.SeasonType})
+	}
+
+	return and(conds)
+}
+
+func (r *TeamSeasonStatsRepository) List(ctx context.Context, filter *TeamSeasonStatsFilter, opts *ListOptions) (*Page[models.TeamSeasonStats], error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "team_season_stats_repository.List",
+		"team":      filter.Team,
+		"limit":     opts.Limit,
+		"offset":    opts.Offset,
+	})
+	log.Info("Listing team season stats")
+
+	page, err := findPage[models.TeamSeasonStats](ctx, r.collection, filter.bson(), opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to list team season stats")
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"count": len(page.Items),
+		"total": page.Total,
+	}).Info("Team season stats retrieved successfully")
+	return page, nil
+}
+
+func (r *TeamSeasonStatsRepository) FindBySeason(ctx context.Context, season int) ([]models.TeamSeasonStats, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "team_season_stats_repository.FindBySeason").WithField("season", season)
+	log.Info("Finding team season stats by season")
+
+	var stats []models.TeamSeasonStats
+	cursor, err := r.collection.Find(ctx, bson.M{"Season": season})
+	if err != nil {
+		log.WithError(err).Error("Failed to find team season stats by season")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &stats); err != nil {
+		log.WithError(err).Error("Failed to decode team season stats")
+		return nil, err
+	}
+
+	log.WithField("count", len(stats)).Info("Team season stats retrieved successfully")
+	return stats, nil
+}
+
+func (r *TeamSeasonStatsRepository) BulkUpsertByTeamAndSeason(ctx context.Context, stats []models.TeamSeasonStats) (*BulkResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "team_season_stats_repository.BulkUpsertByTeamAndSeason",
+		"count":     len(stats),
+	})
+	log.Info("Bulk upserting team season stats")
+
+	now := time.Now()
+	// A document whose LastUpdated is already set keeps it, so an unchanged document is written as a no-op
+	for i := range stats {
+		if stats[i].LastUpdated.IsZero() {
+			stats[i].LastUpdated = now
+		}
+	}
+
+	result, err := bulkUpsert(ctx, r.collection, stats, func(s *models.TeamSeasonStats) bson.M {
+		return bson.M{"Team": s.Team, "Season": s.Season, "SeasonType": s.SeasonType}
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to bulk upsert team season stats")
+		return result, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"inserted":  result.Inserted,
+		"modified":  result.Modified,
+		"unchanged": result.Unchanged,
+		"failed":    result.Failed,
+		"batches":   len(result.Batches),
+	}).Info("Team season stats bulk upserted")
+	return result, nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -46,6 +47,27 @@ func (r *TeamsRepository) FindAll(ctx context.Context) ([]models.Team, error) {
 	return teams, nil
 }
 
+func (r *TeamsRepository) List(ctx context.Context, opts *ListOptions) (*Page[models.Team], error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "teams_repository.List",
+		"limit":     opts.Limit,
+		"offset":    opts.Offset,
+	})
+	log.Info("Listing teams")
+
+	page, err := findPage[models.Team](ctx, r.collection, bson.M{}, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to list teams")
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"count": len(page.Items),
+		"total": page.Total,
+	}).Info("Teams retrieved successfully")
+	return page, nil
+}
+
 func (r *TeamsRepository) FindByID(ctx context.Context, id string) (*models.Team, error) {
 	log := logger.WithRequestContext(ctx).WithField("component", "teams_repository.FindByID").WithField("team_id", id)
 	log.Info("Finding team by ID")
@@ -164,6 +186,39 @@ func (r *TeamsRepository) UpsertByTeamID(ctx context.Context, team *models.Team)
 	return &updatedTeam, nil
 }
 
+func (r *TeamsRepository) BulkUpsertByTeamID(ctx context.Context, teams []models.Team) (*BulkResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "teams_repository.BulkUpsertByTeamID",
+		"count":     len(teams),
+	})
+	log.Info("Bulk upserting teams")
+
+	now := time.Now()
+	// A document whose LastUpdated is already set keeps it, so an unchanged document is written as a no-op
+	for i := range teams {
+		if teams[i].LastUpdated.IsZero() {
+			teams[i].LastUpdated = now
+		}
+	}
+
+	result, err := bulkUpsert(ctx, r.collection, teams, func(team *models.Team) bson.M {
+		return bson.M{"TeamID": team.TeamID}
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to bulk upsert teams")
+		return result, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"inserted":  result.Inserted,
+		"modified":  result.Modified,
+		"unchanged": result.Unchanged,
+		"failed":    result.Failed,
+		"batches":   len(result.Batches),
+	}).Info("Teams bulk upserted")
+	return result, nil
+}
+
 func (r *TeamsRepository) Delete(ctx context.Context, id string) error {
 	log := logger.WithRequestContext(ctx).WithField("component", "teams_repository.Delete").WithField("team_id", id)
 	log.Info("Deleting team")
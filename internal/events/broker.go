@@ -0,0 +1,192 @@
+package events
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Event types published by the sync pipeline
+const (
+	TypeGameUpdated     = "game.updated"
+	TypeTeamUpdated     = "team.updated"
+	TypePlayerUpdated   = "player.updated"
+	TypeStandingUpdated = "standing.updated"
+)
+
+// Event is a change notification delivered to subscribers
+type Event struct {
+	ID     uint64                 `json:"id"`
+	Type   string                 `json:"type"`
+	Topics []string               `json:"topics"`
+	Data   interface{}            `json:"data"`
+	Diff   map[string]interface{} `json:"diff"`
+	Time   time.Time              `json:"time"`
+}
+
+// HasTopic reports whether the event was published on topic
+func (e *Event) HasTopic(topic string) bool {
+	for _, t := range e.Topics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// Broker fans out published events to subscribers and keeps a bounded history so that
+// reconnecting clients can resume from the last event they saw
+type Broker struct {
+	mu          sync.Mutex
+	nextID      uint64
+	history     []Event
+	historySize int
+	subscribers map[*Subscription]struct{}
+	// done is closed by Close, when the server shuts down
+	done chan struct{}
+}
+
+// Subscription receives events on C until it is closed. C is closed when the
+// subscriber falls too far behind, at which point it should resubscribe.
+type Subscription struct {
+	C      chan Event
+	broker *Broker
+	closed bool
+}
+
+const subscriptionBuffer = 64
+
+// NewBroker creates a broker that remembers the last historySize events
+func NewBroker(historySize int) *Broker {
+	return &Broker{
+		nextID:      1,
+		historySize: historySize,
+		subscribers: map[*Subscription]struct{}{},
+		done:        make(chan struct{}),
+	}
+}
+
+// Close ends every subscription and every later one, so that long-lived streams don't hold up the
+// server's shutdown
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	select {
+	case <-b.done:
+		return
+	default:
+	}
+	close(b.done)
+	for sub := range b.subscribers {
+		b.closeLocked(sub)
+	}
+}
+
+// Done is closed once the broker is closed
+func (b *Broker) Done() <-chan struct{} {
+	return b.done
+}
+
+// Publish assigns the event an ID and delivers it to all subscribers. data is the full document
+// and diff holds only its changed fields, as computed by Diff.
+func (b *Broker) Publish(eventType string, topics []string, data interface{}, diff map[string]interface{}) Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	event := Event{
+		ID:     b.nextID,
+		Type:   eventType,
+		Topics: topics,
+		Data:   data,
+		Diff:   diff,
+		Time:   time.Now(),
+	}
+	b.nextID++
+
+	b.history = append(b.history, event)
+	if len(b.history) > b.historySize {
+		b.history = b.history[len(b.history)-b.historySize:]
+	}
+
+	for sub := range b.subscribers {
+		select {
+		case sub.C <- event:
+		default:
+			// Drop slow subscribers instead of blocking the sync pipeline
+			b.closeLocked(sub)
+		}
+	}
+
+	return event
+}
+
+// Subscribe registers a new subscription. It also returns the buffered events published after
+// lastEventID; pass 0 to skip the backlog. If lastEventID is unknown (e.g. from before a restart)
+// the whole buffer is returned.
+func (b *Broker) Subscribe(lastEventID uint64) (*Subscription, []Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub := &Subscription{
+		C:      make(chan Event, subscriptionBuffer),
+		broker: b,
+	}
+	select {
+	case <-b.done:
+		b.closeLocked(sub)
+		return sub, nil
+	default:
+	}
+	b.subscribers[sub] = struct{}{}
+
+	if lastEventID == 0 {
+		return sub, nil
+	}
+
+	backlog := []Event{}
+	for _, event := range b.history {
+		if event.ID > lastEventID || lastEventID >= b.nextID {
+			backlog = append(backlog, event)
+		}
+	}
+
+	return sub, backlog
+}
+
+// Close unregisters the subscription
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	s.broker.closeLocked(s)
+}
+
+func (b *Broker) closeLocked(sub *Subscription) {
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	delete(b.subscribers, sub)
+	close(sub.C)
+}
+
+// GameTopic is the topic for changes to a single game
+func GameTopic(gameKey string) string {
+	return "game:" + gameKey
+}
+
+// TeamTopic is the topic for changes involving a team
+func TeamTopic(teamKey string) string {
+	return "team:" + teamKey
+}
+
+// PlayerTopic is the topic for changes to a single player
+func PlayerTopic(playerID int) string {
+	return "player:" + strconv.Itoa(playerID)
+}
+
+// StandingsTopic is the topic for standings changes within a division
+func StandingsTopic(conference string, division string) string {
+	return "standings:" + conference + "/" + division
+}
@@ -0,0 +1,56 @@
+package events
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// ignoredDiffFields change on every upsert and are left out of diffs
+var ignoredDiffFields = map[string]bool{
+	"id":          true,
+	"lastUpdated": true,
+}
+
+// Diff returns the JSON fields of new whose values differ from old, keyed by JSON field name.
+// When old is nil every field of new is returned. An empty result means nothing changed.
+func Diff(old interface{}, new interface{}) (map[string]interface{}, error) {
+	newFields, err := toFields(new)
+	if err != nil {
+		return nil, err
+	}
+
+	oldFields := map[string]interface{}{}
+	if v := reflect.ValueOf(old); old != nil && !(v.Kind() == reflect.Ptr && v.IsNil()) {
+		oldFields, err = toFields(old)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	diff := map[string]interface{}{}
+	for field, value := range newFields {
+		if ignoredDiffFields[field] {
+			continue
+		}
+		if oldValue, ok := oldFields[field]; ok && reflect.DeepEqual(oldValue, value) {
+			continue
+		}
+		diff[field] = value
+	}
+
+	return diff, nil
+}
+
+// toFields converts a document to its generic JSON representation
+func toFields(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
@@ -0,0 +1,237 @@
+// Package projections simulates the rest of a season to project playoff chances
+package projections
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// maxCachedProjections bounds the cache; the oldest projection is dropped first
+const maxCachedProjections = 32
+
+// TeamProjection is a team's current record and its chances over the simulated seasons
+type TeamProjection struct {
+	Team       string `json:"team"`
+	Conference string `json:"conference"`
+	Division   string `json:"division"`
+	Wins       int    `json:"wins"`
+	Losses     int    `json:"losses"`
+	Ties       int    `json:"ties"`
+
+	ProjectedWins float64 `json:"projectedWins"`
+	PlayoffOdds   float64 `json:"playoffOdds"`
+	DivisionOdds  float64 `json:"divisionOdds"`
+	ByeOdds       float64 `json:"byeOdds"`
+	// SeedOdds holds the chance of each playoff seed, the first entry being the top seed
+	SeedOdds []float64 `json:"seedOdds"`
+}
+
+// Projection is the outcome of simulating the remaining regular season games of a season
+type Projection struct {
+	Season         int       `json:"season"`
+	Iterations     int       `json:"iterations"`
+	Seed           int64     `json:"seed"`
+	ThroughWeek    int       `json:"throughWeek"`
+	RemainingGames int       `json:"remainingGames"`
+	GeneratedAt    time.Time `json:"generatedAt"`
+	// Teams are ordered by conference, then by playoff odds
+	Teams []TeamProjection `json:"teams"`
+}
+
+type cacheKey struct {
+	season     int
+	iterations int
+	seed       int64
+}
+
+func (k cacheKey) String() string {
+	return fmt.Sprintf("%d/%d/%d", k.season, k.iterations, k.seed)
+}
+
+type Service struct {
+	teamsRepo     *repositories.TeamsRepository
+	gamesRepo     *repositories.GamesRepository
+	schedulesRepo *repositories.SchedulesRepository
+
+	// simulations collapses concurrent requests for the same uncached projection into one simulation
+	simulations singleflight.Group
+
+	mu    sync.Mutex
+	cache map[cacheKey]*Projection
+	order []cacheKey
+	// generation counts invalidations, so a simulation that started before one isn't cached
+	generation uint64
+}
+
+func NewService(
+	teamsRepo *repositories.TeamsRepository,
+	gamesRepo *repositories.GamesRepository,
+	schedulesRepo *repositories.SchedulesRepository,
+) *Service {
+	return &Service{
+		teamsRepo:     teamsRepo,
+		gamesRepo:     gamesRepo,
+		schedulesRepo: schedulesRepo,
+		cache:         map[cacheKey]*Projection{},
+	}
+}
+
+// PlayoffOdds simulates the rest of a regular season iterations times and reports each team's playoff
+// chances. The same seed over the same stored games gives the same projection; projections are cached
+// until the next games or schedules sync changes something. Concurrent requests for the same projection
+// share one simulation.
+func (s *Service) PlayoffOdds(ctx context.Context, season int, iterations int, seed int64) (*Projection, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component":  "projections.PlayoffOdds",
+		"season":     season,
+		"iterations": iterations,
+		"seed":       seed,
+	})
+
+	key := cacheKey{season: season, iterations: iterations, seed: seed}
+	if projection := s.cached(key); projection != nil {
+		log.Info("Playoff odds served from cache")
+		return projection, nil
+	}
+
+	for {
+		results := s.simulations.DoChan(key.String(), func() (interface{}, error) {
+			return s.project(ctx, key)
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case result := <-results:
+			// The request that ran the shared simulation went away; run it again for this one
+			if isContextError(result.Err) && ctx.Err() == nil {
+				continue
+			}
+			if result.Err != nil {
+				return nil, result.Err
+			}
+			return result.Val.(*Projection), nil
+		}
+	}
+}
+
+// project loads a season and simulates it, caching the projection
+func (s *Service) project(ctx context.Context, key cacheKey) (*Projection, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component":  "projections.project",
+		"season":     key.season,
+		"iterations": key.iterations,
+		"seed":       key.seed,
+	})
+
+	// Another simulation may have finished since the cache was checked
+	if projection := s.cached(key); projection != nil {
+		return projection, nil
+	}
+
+	log.Info("Simulating playoff odds")
+	generation := s.currentGeneration()
+	season := key.season
+
+	teams, err := s.teamsRepo.FindAll(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to load teams")
+		return nil, err
+	}
+	games, err := s.gamesRepo.FindBySeason(ctx, season, 1)
+	if err != nil {
+		log.WithError(err).Error("Failed to load games")
+		return nil, err
+	}
+	schedules, err := s.schedulesRepo.FindBySeason(ctx, season, 1)
+	if err != nil {
+		log.WithError(err).Error("Failed to load schedules")
+		return nil, err
+	}
+
+	started := time.Now()
+	projection, err := simulate(ctx, season, teams, games, schedules, key.iterations, rand.New(rand.NewSource(key.seed)))
+	if err != nil {
+		log.WithError(err).Error("Playoff odds simulation stopped")
+		return nil, err
+	}
+	projection.Seed = key.seed
+	s.store(key, projection, generation)
+
+	log.WithFields(logrus.Fields{
+		"remaining_games": projection.RemainingGames,
+		"duration_ms":     time.Since(started).Milliseconds(),
+	}).Info("Playoff odds simulated successfully")
+	return projection, nil
+}
+
+// Invalidate drops every cached projection
+func (s *Service) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cache = map[cacheKey]*Projection{}
+	s.order = nil
+	s.generation++
+}
+
+// GamesSynced is a games synced hook that invalidates the cached projections
+func (s *Service) GamesSynced(ctx context.Context, games []models.Game) error {
+	s.Invalidate()
+	return nil
+}
+
+// SchedulesSynced is a schedules synced hook that invalidates the cached projections
+func (s *Service) SchedulesSynced(ctx context.Context, schedules []models.Schedule) error {
+	s.Invalidate()
+	return nil
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+func (s *Service) cached(key cacheKey) *Projection {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache[key]
+}
+
+func (s *Service) currentGeneration() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.generation
+}
+
+// store caches a projection unless the cache was invalidated since its data was loaded
+func (s *Service) store(key cacheKey, projection *Projection, generation uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if generation != s.generation {
+		return
+	}
+
+	if _, ok := s.cache[key]; !ok {
+		s.order = append(s.order, key)
+	}
+	s.cache[key] = projection
+
+	for len(s.order) > maxCachedProjections {
+		delete(s.cache, s.order[0])
+		s.order = s.order[1:]
+	}
+}
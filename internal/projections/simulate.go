@@ -0,0 +1,201 @@
+package projections
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/standings"
+)
+
+const (
+	// marginStdDev is the standard deviation of NFL scoring margins around the point spread
+	marginStdDev = 13.5
+	// homeField is how many points a rating-based spread gives the home team
+	homeField = 2.0
+	// ratingPriorGames shrinks ratings toward zero as if every team had also played this many even games
+	ratingPriorGames = 2
+	// losingScore is the score of a simulated loser; the winner gets it plus the margin
+	losingScore = 17
+)
+
+// remainingGame is a game left to play with the home team's expected margin
+type remainingGame struct {
+	week           int
+	home           string
+	away           string
+	expectedMargin float64
+}
+
+type tally struct {
+	wins      int
+	playoffs  int
+	divisions int
+	byes      int
+	seeds     []int
+}
+
+// simulate plays out the season's scheduled games that are not final yet. A game's margin is drawn from
+// a normal distribution around its point spread, or around the difference of the teams' ratings when it
+// has no line, and the standings are computed for every simulated season. It stops with the context's
+// error once the context is done.
+func simulate(ctx context.Context, season int, teams []models.Team, games []models.Game, schedules []models.Schedule, iterations int, rng *rand.Rand) (*Projection, error) {
+	known := make(map[string]bool, len(teams))
+	for _, team := range teams {
+		known[team.Key] = true
+	}
+
+	var played []models.Game
+	final := map[string]bool{}
+	for _, game := range games {
+		if game.Status == models.GameStatusFinal || game.Status == models.GameStatusFinalOT {
+			played = append(played, game)
+			final[game.GameKey] = true
+		}
+	}
+
+	ratings := rate(played)
+	var remaining []remainingGame
+	for _, schedule := range schedules {
+		if schedule.Canceled || schedule.GameKey == "" || final[schedule.GameKey] {
+			continue
+		}
+		if !known[schedule.HomeTeam] || !known[schedule.AwayTeam] {
+			continue
+		}
+
+		// A pick'em spread is 0 too, so a game only has no line when the over/under is missing as well
+		expected := -schedule.PointSpread
+		if schedule.PointSpread == 0 && schedule.OverUnder == 0 {
+			expected = ratings[schedule.HomeTeam] - ratings[schedule.AwayTeam] + homeField
+		}
+		remaining = append(remaining, remainingGame{
+			week:           schedule.Week,
+			home:           schedule.HomeTeam,
+			away:           schedule.AwayTeam,
+			expectedMargin: expected,
+		})
+	}
+
+	playoffTeams, _ := standings.Format(season)
+	tallies := make(map[string]*tally, len(teams))
+	for _, team := range teams {
+		tallies[team.Key] = &tally{seeds: make([]int, playoffTeams)}
+	}
+
+	simulated := make([]models.Game, len(played)+len(remaining))
+	copy(simulated, played)
+	for i := 0; i < iterations; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for j, game := range remaining {
+			margin := int(math.Round(game.expectedMargin + rng.NormFloat64()*marginStdDev))
+			if margin == 0 {
+				// Ties are rare enough to leave out, so the favorite wins by a point
+				margin = 1
+				if game.expectedMargin < 0 {
+					margin = -1
+				}
+			}
+
+			result := models.Game{
+				Week:      game.week,
+				Status:    models.GameStatusFinal,
+				HomeTeam:  game.home,
+				AwayTeam:  game.away,
+				HomeScore: losingScore,
+				AwayScore: losingScore,
+			}
+			if margin > 0 {
+				result.HomeScore += margin
+			} else {
+				result.AwayScore -= margin
+			}
+			simulated[len(played)+j] = result
+		}
+
+		for _, standing := range standings.Compute(season, teams, simulated).Teams {
+			t := tallies[standing.Team]
+			t.wins += standing.Wins
+			if standing.Seed > 0 {
+				t.playoffs++
+				t.seeds[standing.Seed-1]++
+			}
+			if standing.DivisionWinner {
+				t.divisions++
+			}
+			if standing.Bye {
+				t.byes++
+			}
+		}
+	}
+
+	current := standings.Compute(season, teams, played)
+	projection := &Projection{
+		Season:         season,
+		Iterations:     iterations,
+		ThroughWeek:    current.ThroughWeek,
+		RemainingGames: len(remaining),
+		GeneratedAt:    time.Now(),
+		Teams:          make([]TeamProjection, 0, len(current.Teams)),
+	}
+	for _, standing := range current.Teams {
+		t := tallies[standing.Team]
+		team := TeamProjection{
+			Team:          standing.Team,
+			Conference:    standing.Conference,
+			Division:      standing.Division,
+			Wins:          standing.Wins,
+			Losses:        standing.Losses,
+			Ties:          standing.Ties,
+			ProjectedWins: share(t.wins, iterations),
+			PlayoffOdds:   share(t.playoffs, iterations),
+			DivisionOdds:  share(t.divisions, iterations),
+			ByeOdds:       share(t.byes, iterations),
+			SeedOdds:      make([]float64, playoffTeams),
+		}
+		for seed, count := range t.seeds {
+			team.SeedOdds[seed] = share(count, iterations)
+		}
+		projection.Teams = append(projection.Teams, team)
+	}
+
+	sort.SliceStable(projection.Teams, func(a, b int) bool {
+		ta, tb := projection.Teams[a], projection.Teams[b]
+		if ta.Conference != tb.Conference {
+			return ta.Conference < tb.Conference
+		}
+		return ta.PlayoffOdds > tb.PlayoffOdds
+	})
+	return projection, nil
+}
+
+// rate rates each team by its average scoring margin in the played games, shrunk toward zero
+func rate(played []models.Game) map[string]float64 {
+	margins := map[string]int{}
+	counts := map[string]int{}
+	for _, game := range played {
+		margins[game.HomeTeam] += game.HomeScore - game.AwayScore
+		margins[game.AwayTeam] += game.AwayScore - game.HomeScore
+		counts[game.HomeTeam]++
+		counts[game.AwayTeam]++
+	}
+
+	ratings := make(map[string]float64, len(margins))
+	for team, margin := range margins {
+		ratings[team] = float64(margin) / float64(counts[team]+ratingPriorGames)
+	}
+	return ratings
+}
+
+// share is count as a fraction of the iterations, rounded to three decimals
+func share(count int, iterations int) float64 {
+	if iterations == 0 {
+		return 0
+	}
+	return math.Round(float64(count)/float64(iterations)*1000) / 1000
+}
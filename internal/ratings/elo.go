@@ -0,0 +1,140 @@
+package ratings
+
+import (
+	"math"
+	"time"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+)
+
+const (
+	// initialRating is the rating of a team without games, and the mean ratings regress to
+	initialRating = 1500.0
+	// kFactor scales how far one game moves the ratings
+	kFactor = 20.0
+	// homeFieldAdvantage is added to the home team's rating when predicting a game
+	homeFieldAdvantage = 48.0
+	// seasonRegression is the share of its distance from the mean a rating loses between seasons
+	seasonRegression = 1.0 / 3
+)
+
+// teamState is a team's latest rating and the season it belongs to
+type teamState struct {
+	Rating float64
+	Season int
+}
+
+// engine replays games in order and records each team's rating after every week it played
+type engine struct {
+	states  map[string]teamState
+	entries []Rating
+	now     time.Time
+}
+
+func newEngine(states map[string]teamState) *engine {
+	if states == nil {
+		states = map[string]teamState{}
+	}
+	// MongoDB stores times to the millisecond; replay relies on reading back exactly this time
+	return &engine{states: states, now: time.Now().Truncate(time.Millisecond)}
+}
+
+// playWeek applies the games of one week, which must come after every game played so far
+func (e *engine) playWeek(games []models.Game) {
+	if len(games) == 0 {
+		return
+	}
+	season, seasonType, week := games[0].Season, games[0].SeasonType, games[0].Week
+
+	before := map[string]float64{}
+	played := map[string]int{}
+	for i := range games {
+		game := &games[i]
+		for _, team := range []string{game.HomeTeam, game.AwayTeam} {
+			e.startSeason(team, season)
+			if _, ok := before[team]; !ok {
+				before[team] = e.states[team].Rating
+			}
+			played[team]++
+		}
+		e.play(game)
+	}
+
+	for team, rating := range before {
+		state := e.states[team]
+		e.entries = append(e.entries, Rating{
+			Team:       team,
+			Season:     season,
+			SeasonType: seasonType,
+			Week:       week,
+			Rating:     state.Rating,
+			Change:     state.Rating - rating,
+			Games:      played[team],
+			Order:      order(season, seasonType, week),
+			UpdatedAt:  e.now,
+		})
+	}
+}
+
+// startSeason regresses a team's rating toward the mean once for every season since its last game, and
+// records the regressed rating as week 0 of the new season. A new team starts at the mean.
+func (e *engine) startSeason(team string, season int) {
+	state, ok := e.states[team]
+	if ok && state.Season >= season {
+		return
+	}
+
+	rating, change := initialRating, 0.0
+	if ok {
+		rating = state.Rating
+		for s := state.Season; s < season; s++ {
+			rating = initialRating + (rating-initialRating)*(1-seasonRegression)
+		}
+		change = rating - state.Rating
+	}
+	e.states[team] = teamState{Rating: rating, Season: season}
+	e.entries = append(e.entries, Rating{
+		Team:       team,
+		Season:     season,
+		SeasonType: 1,
+		Week:       0,
+		Rating:     rating,
+		Change:     change,
+		Order:      order(season, 1, 0),
+		UpdatedAt:  e.now,
+	})
+}
+
+// play moves both teams' ratings by the game's result
+func (e *engine) play(game *models.Game) {
+	home, away := e.states[game.HomeTeam], e.states[game.AwayTeam]
+
+	diff := home.Rating + homeFieldAdvantage - away.Rating
+	expected := 1 / (1 + math.Pow(10, -diff/400))
+
+	margin := game.HomeScore - game.AwayScore
+	actual := 0.5
+	switch {
+	case margin > 0:
+		actual = 1
+	case margin < 0:
+		actual, diff = 0, -diff
+	}
+
+	// The margin of victory multiplier grows with the margin, but less so for a favorite that won,
+	// whose ratings would otherwise keep inflating. A tie moves the ratings without a multiplier.
+	multiplier := 1.0
+	if margin != 0 {
+		multiplier = math.Log(math.Abs(float64(margin))+1) * 2.2 / (diff*0.001 + 2.2)
+	}
+
+	shift := kFactor * multiplier * (actual - expected)
+	home.Rating += shift
+	away.Rating -= shift
+	e.states[game.HomeTeam], e.states[game.AwayTeam] = home, away
+}
+
+// order sorts weeks chronologically: regular season weeks come before postseason weeks
+func order(season int, seasonType int, week int) int {
+	return season*1000 + seasonType*100 + week
+}
@@ -0,0 +1,102 @@
+package ratings
+
+import (
+	"math"
+	"testing"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+)
+
+func TestEnginePlay(t *testing.T) {
+	tests := []struct {
+		name      string
+		home      float64
+		away      float64
+		homeScore int
+		awayScore int
+		// shift is how far the home team's rating should move
+		shift float64
+	}{
+		{name: "home win", home: 1500, away: 1500, homeScore: 24, awayScore: 17, shift: 17.5566},
+		{name: "bigger margin moves further", home: 1500, away: 1500, homeScore: 38, awayScore: 17, shift: 26.0976},
+		{name: "away upset", home: 1500, away: 1500, homeScore: 17, awayScore: 24, shift: -24.1766},
+		{name: "tie against the home edge", home: 1500, away: 1500, homeScore: 20, awayScore: 20, shift: -1.3728},
+		{name: "tie of even teams", home: 1452, away: 1500, homeScore: 20, awayScore: 20, shift: 0},
+		{name: "heavy favorite wins narrowly", home: 1600, away: 1400, homeScore: 20, awayScore: 17, shift: 4.8208},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newEngine(map[string]teamState{
+				"HOME": {Rating: tt.home, Season: 2023},
+				"AWAY": {Rating: tt.away, Season: 2023},
+			})
+			e.play(&models.Game{HomeTeam: "HOME", AwayTeam: "AWAY", HomeScore: tt.homeScore, AwayScore: tt.awayScore})
+
+			home, away := e.states["HOME"].Rating, e.states["AWAY"].Rating
+			if math.Abs(home-tt.home-tt.shift) > 0.001 {
+				t.Errorf("home rating moved by %.4f, want %.4f", home-tt.home, tt.shift)
+			}
+			if math.Abs((home-tt.home)+(away-tt.away)) > 1e-9 {
+				t.Errorf("ratings moved by %.4f and %.4f, want them to cancel out", home-tt.home, away-tt.away)
+			}
+		})
+	}
+}
+
+func TestEngineStartSeason(t *testing.T) {
+	tests := []struct {
+		name   string
+		states map[string]teamState
+		// want is the rating the home team starts the 2023 season with
+		want float64
+	}{
+		{name: "new team", states: nil, want: initialRating},
+		{name: "one offseason", states: map[string]teamState{"HOME": {Rating: 1590, Season: 2022}}, want: 1560},
+		{name: "two offseasons", states: map[string]teamState{"HOME": {Rating: 1590, Season: 2021}}, want: 1540},
+		{name: "same season", states: map[string]teamState{"HOME": {Rating: 1590, Season: 2023}}, want: 1590},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			started := tt.states["HOME"].Season == 2023
+			e := newEngine(tt.states)
+			e.startSeason("HOME", 2023)
+
+			if got := e.states["HOME"].Rating; math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("rating = %.4f, want %.4f", got, tt.want)
+			}
+			if started {
+				if len(e.entries) != 0 {
+					t.Errorf("recorded %d entries, want none", len(e.entries))
+				}
+				return
+			}
+			if len(e.entries) != 1 || e.entries[0].Week != 0 || e.entries[0].Order != order(2023, 1, 0) {
+				t.Errorf("entries = %+v, want one week 0 entry", e.entries)
+			}
+		})
+	}
+}
+
+func TestEnginePlayWeek(t *testing.T) {
+	e := newEngine(map[string]teamState{
+		"BUF": {Rating: 1550, Season: 2023},
+		"MIA": {Rating: 1500, Season: 2023},
+	})
+	e.playWeek([]models.Game{
+		{Season: 2023, SeasonType: 1, Week: 3, HomeTeam: "BUF", AwayTeam: "MIA", HomeScore: 48, AwayScore: 20},
+	})
+
+	if len(e.entries) != 2 {
+		t.Fatalf("recorded %d entries, want 2", len(e.entries))
+	}
+	for _, entry := range e.entries {
+		if entry.Week != 3 || entry.Games != 1 || entry.Order != order(2023, 1, 3) {
+			t.Errorf("entry = %+v, want week 3 with one game", entry)
+		}
+		if entry.Rating != e.states[entry.Team].Rating {
+			t.Errorf("%s: entry rating %.4f, want the team's rating %.4f", entry.Team, entry.Rating, e.states[entry.Team].Rating)
+		}
+	}
+}
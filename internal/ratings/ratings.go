@@ -0,0 +1,335 @@
+// Package ratings computes Elo ratings of the teams game by game and keeps their weekly history
+package ratings
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// Rating is a team's rating after the games it played in a week. Week 0 of the regular season holds
+// the rating the team started the season with.
+type Rating struct {
+	Team       string  `bson:"Team" json:"team"`
+	Season     int     `bson:"Season" json:"season"`
+	SeasonType int     `bson:"SeasonType" json:"seasonType"`
+	Week       int     `bson:"Week" json:"week"`
+	Rating     float64 `bson:"Rating" json:"rating"`
+	// Change is how much the week's games, or the regression between seasons, moved the rating
+	Change float64 `bson:"Change" json:"change"`
+	Games  int     `bson:"Games" json:"games"`
+	// Order sorts the ratings chronologically across seasons and season types
+	Order     int       `bson:"Order" json:"-"`
+	UpdatedAt time.Time `bson:"UpdatedAt" json:"updatedAt"`
+}
+
+// RankedRating is a team's rating with its rank among all teams
+type RankedRating struct {
+	Rank int `json:"rank"`
+	Rating
+}
+
+type Service struct {
+	games   *mongo.Collection
+	ratings *mongo.Collection
+
+	// mu serializes recomputes and updates, which both rewrite the history
+	mu sync.Mutex
+}
+
+func NewService(client *mongo.Database) *Service {
+	return &Service{
+		games:   client.Collection("games"),
+		ratings: client.Collection("ratings"),
+	}
+}
+
+// Ratings returns every team's latest rating as of the end of a week of a season, best first. Without
+// a week, the ratings as of the end of the season, postseason included, are returned.
+func (s *Service) Ratings(ctx context.Context, season int, seasonType int, week *int) ([]RankedRating, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component":   "ratings.Ratings",
+		"season":      season,
+		"season_type": seasonType,
+	})
+	log.Info("Finding ratings")
+
+	through := order(season+1, 0, 0) - 1
+	if week != nil {
+		through = order(season, seasonType, *week)
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"Season": season,
+			"Order":  bson.M{"$lte": through},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "Order", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":    "$Team",
+			"Latest": bson.M{"$first": "$$ROOT"},
+		}}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$Latest"}}},
+		{{Key: "$sort", Value: bson.D{{Key: "Rating", Value: -1}, {Key: "Team", Value: 1}}}},
+	}
+
+	cursor, err := s.ratings.Aggregate(ctx, pipeline)
+	if err != nil {
+		log.WithError(err).Error("Failed to find ratings")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var latest []Rating
+	if err := cursor.All(ctx, &latest); err != nil {
+		log.WithError(err).Error("Failed to decode ratings")
+		return nil, err
+	}
+
+	ranked := make([]RankedRating, 0, len(latest))
+	for i, rating := range latest {
+		ranked = append(ranked, RankedRating{Rank: i + 1, Rating: rounded(rating)})
+	}
+
+	log.WithField("count", len(ranked)).Info("Ratings retrieved successfully")
+	return ranked, nil
+}
+
+// TeamHistory returns a team's weekly ratings in chronological order, of one season if season is set
+func (s *Service) TeamHistory(ctx context.Context, team string, season *int) ([]Rating, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "ratings.TeamHistory",
+		"team":      team,
+	})
+	log.Info("Finding rating history")
+
+	filter := bson.M{"Team": team}
+	if season != nil {
+		filter["Season"] = *season
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "Order", Value: 1}})
+
+	history := []Rating{}
+	cursor, err := s.ratings.Find(ctx, filter, opts)
+	if err != nil {
+		log.WithError(err).Error("Failed to find rating history")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &history); err != nil {
+		log.WithError(err).Error("Failed to decode rating history")
+		return nil, err
+	}
+	for i := range history {
+		history[i] = rounded(history[i])
+	}
+
+	log.WithField("count", len(history)).Info("Rating history retrieved successfully")
+	return history, nil
+}
+
+// Recompute rebuilds the whole rating history from every stored final regular season and postseason game
+func (s *Service) Recompute(ctx context.Context) (int, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "ratings.Recompute")
+	log.Info("Recomputing ratings")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count, err := s.replay(ctx, 0)
+	if err != nil {
+		log.WithError(err).Error("Failed to recompute ratings")
+		return 0, err
+	}
+
+	log.WithField("ratings", count).Info("Ratings recomputed successfully")
+	return count, nil
+}
+
+// UpdateRatings brings the rating history up to date with the given games. It is meant to run after
+// every games sync with the games the sync changed. Ratings depend on every earlier game, so the history
+// is replayed from the week of the earliest changed final game on.
+func (s *Service) UpdateRatings(ctx context.Context, games []models.Game) error {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "ratings.UpdateRatings",
+		"games":     len(games),
+	})
+
+	from := 0
+	for i := range games {
+		if !counts(&games[i]) {
+			continue
+		}
+		if o := order(games[i].Season, games[i].SeasonType, games[i].Week); from == 0 || o < from {
+			from = o
+		}
+	}
+	if from == 0 {
+		return nil
+	}
+
+	log.WithField("from", from).Info("Updating ratings")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count, err := s.replay(ctx, from)
+	if err != nil {
+		log.WithError(err).Error("Failed to update ratings")
+		return err
+	}
+
+	log.WithField("ratings", count).Info("Ratings updated successfully")
+	return nil
+}
+
+// replay replaces the ratings from the given week on, starting from each team's latest earlier rating.
+// From 0 replays every game. The new ratings are written over the old ones before the ratings that no
+// longer exist are deleted, so the history is never missing while it is replayed.
+func (s *Service) replay(ctx context.Context, from int) (int, error) {
+	states, err := s.statesBefore(ctx, from)
+	if err != nil {
+		return 0, fmt.Errorf("load ratings: %w", err)
+	}
+
+	games, err := s.gamesFrom(ctx, from)
+	if err != nil {
+		return 0, fmt.Errorf("load games: %w", err)
+	}
+
+	e := newEngine(states)
+	for start := 0; start < len(games); {
+		end := start + 1
+		week := order(games[start].Season, games[start].SeasonType, games[start].Week)
+		for end < len(games) && order(games[end].Season, games[end].SeasonType, games[end].Week) == week {
+			end++
+		}
+		e.playWeek(games[start:end])
+		start = end
+	}
+
+	if len(e.entries) > 0 {
+		writes := make([]mongo.WriteModel, 0, len(e.entries))
+		for i := range e.entries {
+			writes = append(writes, mongo.NewReplaceOneModel().
+				SetFilter(bson.M{"Team": e.entries[i].Team, "Order": e.entries[i].Order}).
+				SetReplacement(&e.entries[i]).
+				SetUpsert(true))
+		}
+		if _, err := s.ratings.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(true)); err != nil {
+			return 0, fmt.Errorf("save ratings: %w", err)
+		}
+	}
+
+	// Every rating this replay wrote has the engine's time, so older ones from the replayed weeks are stale
+	stale := bson.M{"Order": bson.M{"$gte": from}, "UpdatedAt": bson.M{"$lt": e.now}}
+	if _, err := s.ratings.DeleteMany(ctx, stale); err != nil {
+		return 0, fmt.Errorf("delete stale ratings: %w", err)
+	}
+	return len(e.entries), nil
+}
+
+// statesBefore loads each team's latest rating from before the given week
+func (s *Service) statesBefore(ctx context.Context, from int) (map[string]teamState, error) {
+	states := map[string]teamState{}
+	if from == 0 {
+		return states, nil
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"Order": bson.M{"$lt": from}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "Order", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":    "$Team",
+			"Rating": bson.M{"$first": "$Rating"},
+			"Season": bson.M{"$first": "$Season"},
+		}}},
+	}
+
+	cursor, err := s.ratings.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		Team   string  `bson:"_id"`
+		Rating float64 `bson:"Rating"`
+		Season int     `bson:"Season"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		states[row.Team] = teamState{Rating: row.Rating, Season: row.Season}
+	}
+	return states, nil
+}
+
+// gamesFrom loads the final regular season and postseason games from the given week on, in the order
+// they were played
+func (s *Service) gamesFrom(ctx context.Context, from int) ([]models.Game, error) {
+	filter := bson.M{
+		"SeasonType": bson.M{"$in": []int{1, 3}},
+		"Status":     bson.M{"$in": []string{models.GameStatusFinal, models.GameStatusFinalOT}},
+	}
+	if from > 0 {
+		season, seasonType, week := from/1000, from%1000/100, from%100
+		filter["$or"] = []bson.M{
+			{"Season": bson.M{"$gt": season}},
+			{"Season": season, "SeasonType": bson.M{"$gt": seasonType}},
+			{"Season": season, "SeasonType": seasonType, "Week": bson.M{"$gte": week}},
+		}
+	}
+
+	cursor, err := s.games.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	games := []models.Game{}
+	if err := cursor.All(ctx, &games); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(games, func(a, b int) bool {
+		oa := order(games[a].Season, games[a].SeasonType, games[a].Week)
+		ob := order(games[b].Season, games[b].SeasonType, games[b].Week)
+		if oa != ob {
+			return oa < ob
+		}
+		if !games[a].Date.Equal(games[b].Date) {
+			return games[a].Date.Before(games[b].Date)
+		}
+		return games[a].GameKey < games[b].GameKey
+	})
+	return games, nil
+}
+
+// counts reports whether a game is a final regular season or postseason game
+func counts(game *models.Game) bool {
+	if game.SeasonType != 1 && game.SeasonType != 3 {
+		return false
+	}
+	return game.Status == models.GameStatusFinal || game.Status == models.GameStatusFinalOT
+}
+
+// rounded rounds a rating for display; the stored history keeps full precision
+func rounded(rating Rating) Rating {
+	rating.Rating = math.Round(rating.Rating*10) / 10
+	rating.Change = math.Round(rating.Change*10) / 10
+	return rating
+}
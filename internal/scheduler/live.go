@@ -0,0 +1,185 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/config"
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+	"github.com/web-dev-jesus/trendzone/internal/sportsdata"
+)
+
+// LivePoller polls in-progress scores while scheduled games are being played
+type LivePoller struct {
+	cfg           *config.LiveConfig
+	service       *sportsdata.Service
+	schedulesRepo *repositories.SchedulesRepository
+	gamesRepo     *repositories.GamesRepository
+	cancel        context.CancelFunc
+	done          chan struct{}
+}
+
+// liveWeek identifies a week of games to poll
+type liveWeek struct {
+	season string
+	week   int
+}
+
+// NewLivePoller creates a live score poller
+func NewLivePoller(
+	cfg *config.Config,
+	service *sportsdata.Service,
+	schedulesRepo *repositories.SchedulesRepository,
+	gamesRepo *repositories.GamesRepository,
+) *LivePoller {
+	return &LivePoller{
+		cfg:           &cfg.Live,
+		service:       service,
+		schedulesRepo: schedulesRepo,
+		gamesRepo:     gamesRepo,
+	}
+}
+
+// Start launches the polling loop
+func (p *LivePoller) Start(ctx context.Context) {
+	log := logger.WithRequestContext(ctx).WithField("component", "live_poller.Start")
+	log.Info("Starting live score poller")
+
+	ctx, p.cancel = context.WithCancel(ctx)
+	p.done = make(chan struct{})
+
+	go p.loop(ctx)
+}
+
+// Stop cancels the polling loop and waits for the current poll to return or for ctx to expire
+func (p *LivePoller) Stop(ctx context.Context) error {
+	log := logger.WithRequestContext(ctx).WithField("component", "live_poller.Stop")
+
+	if p.cancel == nil {
+		return nil
+	}
+
+	log.Info("Stopping live score poller")
+	p.cancel()
+
+	select {
+	case <-p.done:
+		log.Info("Live score poller stopped")
+		return nil
+	case <-ctx.Done():
+		log.Warn("Timed out waiting for live score poll to finish")
+		return ctx.Err()
+	}
+}
+
+// loop polls at PollInterval while games are live and checks for starting games at IdleInterval otherwise
+func (p *LivePoller) loop(ctx context.Context) {
+	defer close(p.done)
+
+	log := logger.WithRequestContext(ctx).WithField("component", "live_poller.loop")
+
+	live := false
+	for {
+		wasLive := live
+		live = p.poll(ctx)
+
+		switch {
+		case live && !wasLive:
+			log.Info("Games in progress, entering live mode")
+		case !live && wasLive:
+			log.Info("All active games final, leaving live mode")
+		}
+
+		wait := p.cfg.IdleInterval
+		if live {
+			wait = p.cfg.PollInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// poll syncs every week that has a game inside its active window and reports whether any of them is not yet final
+func (p *LivePoller) poll(ctx context.Context) bool {
+	log := logger.WithRequestContext(ctx).WithField("component", "live_poller.poll")
+
+	weeks, err := p.activeWeeks(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to find active games")
+		return false
+	}
+
+	live := false
+	for week, gameKeys := range weeks {
+		games, err := p.service.SyncLiveGames(ctx, week.season, week.week, gameKeys)
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				"season": week.season,
+				"week":   week.week,
+			}).WithError(err).Error("Failed to sync live games")
+			// Keep polling; the games are still in their active window
+			live = true
+			continue
+		}
+
+		for _, game := range games {
+			if !game.IsFinal() {
+				live = true
+			}
+		}
+	}
+
+	return live
+}
+
+// activeWeeks groups the GameKeys of scheduled games that are inside their active window and not yet final by week
+func (p *LivePoller) activeWeeks(ctx context.Context) (map[liveWeek][]string, error) {
+	now := time.Now()
+	schedules, err := p.schedulesRepo.FindByDateRange(ctx, now.Add(-p.cfg.MaxGameDuration), now.Add(p.cfg.PregameLead))
+	if err != nil {
+		return nil, err
+	}
+
+	weeks := map[liveWeek][]string{}
+	if len(schedules) == 0 {
+		return weeks, nil
+	}
+
+	gameKeys := make([]string, 0, len(schedules))
+	for _, schedule := range schedules {
+		gameKeys = append(gameKeys, schedule.GameKey)
+	}
+
+	stored, err := p.gamesRepo.FindByGameKeys(ctx, gameKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	final := map[string]bool{}
+	for i := range stored {
+		if stored[i].IsFinal() {
+			final[stored[i].GameKey] = true
+		}
+	}
+
+	for _, schedule := range schedules {
+		if final[schedule.GameKey] || schedule.Status == models.GameStatusPostponed {
+			continue
+		}
+		week := liveWeek{
+			season: sportsdata.SeasonCode(schedule.Season, schedule.SeasonType),
+			week:   schedule.Week,
+		}
+		weeks[week] = append(weeks[week], schedule.GameKey)
+	}
+
+	return weeks, nil
+}
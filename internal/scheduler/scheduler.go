@@ -0,0 +1,185 @@
+package scheduler
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/config"
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+	"github.com/web-dev-jesus/trendzone/internal/sportsdata"
+)
+
+// task is a recurring sync of a single entity
+type task struct {
+	entity   string
+	interval func(ctx context.Context) time.Duration
+	running  atomic.Bool
+}
+
+// Scheduler runs the SportsData.io entity syncs on independent intervals
+type Scheduler struct {
+	cfg           *config.SchedulerConfig
+	season        string
+	service       *sportsdata.Service
+	schedulesRepo *repositories.SchedulesRepository
+	tasks         []*task
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+}
+
+// NewScheduler creates a scheduler for the configured season
+func NewScheduler(
+	cfg *config.Config,
+	service *sportsdata.Service,
+	schedulesRepo *repositories.SchedulesRepository,
+) *Scheduler {
+	s := &Scheduler{
+		cfg:           &cfg.Scheduler,
+		season:        cfg.SportsData.Season,
+		service:       service,
+		schedulesRepo: schedulesRepo,
+	}
+
+	s.tasks = []*task{
+		{entity: sportsdata.EntityTeams, interval: s.fixed(cfg.Scheduler.TeamsInterval)},
+		{entity: sportsdata.EntityPlayers, interval: s.fixed(cfg.Scheduler.PlayersInterval)},
+		{entity: sportsdata.EntityStandings, interval: s.fixed(cfg.Scheduler.StandingsInterval)},
+		{entity: sportsdata.EntitySchedules, interval: s.fixed(cfg.Scheduler.SchedulesInterval)},
+		{entity: sportsdata.EntityGames, interval: s.gamesInterval},
+	}
+
+	return s
+}
+
+// Start launches one loop per task. Each task first runs after a random jitter delay.
+func (s *Scheduler) Start(ctx context.Context) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "scheduler.Start",
+		"season":    s.season,
+	})
+	log.Info("Starting sync scheduler")
+
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	for _, t := range s.tasks {
+		s.wg.Add(1)
+		go s.loop(ctx, t)
+	}
+}
+
+// Stop cancels all task loops and waits for in-flight syncs to return or for ctx to expire
+func (s *Scheduler) Stop(ctx context.Context) error {
+	log := logger.WithRequestContext(ctx).WithField("component", "scheduler.Stop")
+	log.Info("Stopping sync scheduler")
+
+	if s.cancel != nil {
+		s.cancel()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		log.Info("Sync scheduler stopped")
+		return nil
+	case <-ctx.Done():
+		log.Warn("Timed out waiting for scheduled syncs to finish")
+		return ctx.Err()
+	}
+}
+
+// loop triggers the task every interval until ctx is cancelled
+func (s *Scheduler) loop(ctx context.Context, t *task) {
+	defer s.wg.Done()
+
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "scheduler.loop",
+		"entity":    t.entity,
+	})
+
+	timer := time.NewTimer(s.jitter())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		// Skip this tick rather than stacking up runs if the previous one is still going
+		if t.running.CompareAndSwap(false, true) {
+			s.wg.Add(1)
+			go s.run(ctx, t)
+		} else {
+			log.Warn("Previous sync still running, skipping")
+		}
+
+		next := t.interval(ctx) + s.jitter()
+		log.WithField("next_run_in", next.String()).Debug("Scheduled next sync")
+		timer.Reset(next)
+	}
+}
+
+// run executes a single sync of the task's entity, recorded as a sync job
+func (s *Scheduler) run(ctx context.Context, t *task) {
+	defer s.wg.Done()
+	defer t.running.Store(false)
+
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "scheduler.run",
+		"entity":    t.entity,
+	})
+
+	job, err := s.service.RunSyncJob(ctx, s.season, models.SyncTriggerScheduler, t.entity)
+	if err != nil {
+		log.WithError(err).Error("Scheduled sync failed")
+		return
+	}
+
+	log.WithField("job_id", job.JobID).Info("Scheduled sync completed")
+}
+
+// fixed returns an interval function that always yields d
+func (s *Scheduler) fixed(d time.Duration) func(ctx context.Context) time.Duration {
+	return func(ctx context.Context) time.Duration {
+		return d
+	}
+}
+
+// gamesInterval uses the shorter game-day interval when any game is scheduled today
+func (s *Scheduler) gamesInterval(ctx context.Context) time.Duration {
+	now := time.Now()
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	schedules, err := s.schedulesRepo.FindByDateRange(ctx, dayStart, dayStart.AddDate(0, 0, 1))
+	if err != nil {
+		logger.WithRequestContext(ctx).WithField("component", "scheduler.gamesInterval").
+			WithError(err).Warn("Failed to check for games today, using default interval")
+		return s.cfg.GamesInterval
+	}
+
+	if len(schedules) > 0 {
+		return s.cfg.GamesGameDayInterval
+	}
+	return s.cfg.GamesInterval
+}
+
+// jitter returns a random delay in [0, Jitter)
+func (s *Scheduler) jitter() time.Duration {
+	if s.cfg.Jitter <= 0 {
+		return 0
+	}
+	return rand.N(s.cfg.Jitter)
+}
@@ -0,0 +1,44 @@
+package sportsdata
+
+import (
+	"context"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+type forceRefreshKey struct{}
+
+// ForceRefresh returns a context whose SportsData.io requests bypass the response cache,
+// so that every payload is decoded and synced even if it hasn't changed
+func ForceRefresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forceRefreshKey{}, true)
+}
+
+func isForceRefresh(ctx context.Context) bool {
+	force, _ := ctx.Value(forceRefreshKey{}).(bool)
+	return force
+}
+
+// Fetch is the outcome of a successful SportsData.io request
+type Fetch struct {
+	// NotModified is set when the payload is the same as the last synced one; nothing was decoded
+	NotModified bool
+
+	client *Client
+	entry  *models.ResponseCache
+}
+
+// Commit records the response's validators once its data has been synced, so that the same payload
+// is skipped next time. Callers should not commit when the sync failed, so the next one retries it.
+func (f *Fetch) Commit(ctx context.Context) {
+	if f.entry == nil {
+		return
+	}
+
+	if err := f.client.cacheRepo.UpsertByURL(ctx, f.entry); err != nil {
+		// The next sync will just download and sync the payload again
+		logger.WithRequestContext(ctx).WithField("component", "sportsdata_client.Commit").
+			WithField("url", f.entry.URL).WithError(err).Warn("Failed to record response validators")
+	}
+}
@@ -2,64 +2,76 @@ package sportsdata
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
 
 	"github.com/web-dev-jesus/trendzone/config"
 	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
 	"github.com/web-dev-jesus/trendzone/internal/logger"
 )
 
 type Client struct {
-	httpClient *http.Client
-	baseURL    string
-	apiKey     string
+	httpClient     *http.Client
+	baseURL        string
+	apiKey         string
+	limiter        *rate.Limiter
+	maxRetries     int
+	retryBaseDelay time.Duration
+	retryMaxDelay  time.Duration
+	metrics        *metrics
+	cacheRepo      *repositories.ResponseCacheRepository
 }
 
 // NewClient creates a new SportsData.io API client
-func NewClient(cfg *config.SportsDataConfig) *Client {
+func NewClient(cfg *config.SportsDataConfig, cacheRepo *repositories.ResponseCacheRepository) *Client {
+	// A non-positive rate limit disables client-side limiting
+	limit := rate.Inf
+	if cfg.RateLimitPerMinute > 0 {
+		limit = rate.Limit(float64(cfg.RateLimitPerMinute) / 60)
+	}
+
 	return &Client{
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout: cfg.Timeout,
 		},
-		baseURL: cfg.BaseURL,
-		apiKey:  cfg.APIKey,
+		baseURL:        cfg.BaseURL,
+		apiKey:         cfg.APIKey,
+		limiter:        rate.NewLimiter(limit, max(cfg.RateLimitBurst, 1)),
+		maxRetries:     cfg.MaxRetries,
+		retryBaseDelay: cfg.RetryBaseDelay,
+		retryMaxDelay:  cfg.RetryMaxDelay,
+		metrics:        newMetrics(),
+		cacheRepo:      cacheRepo,
 	}
 }
 
+// Metrics returns request metrics per SportsData.io endpoint
+func (c *Client) Metrics() map[string]EndpointMetrics {
+	return c.metrics.snapshot()
+}
+
 // GetTeams retrieves all NFL teams
-func (c *Client) GetTeams(ctx context.Context) ([]models.Team, error) {
+func (c *Client) GetTeams(ctx context.Context) ([]models.Team, *Fetch, error) {
 	log := logger.WithRequestContext(ctx).WithField("component", "sportsdata_client.GetTeams")
 	log.Info("Fetching teams from SportsData.io API")
 
-	url := fmt.Sprintf("%s/scores/json/TeamsBasic?key=%s", c.baseURL, c.apiKey)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		log.WithError(err).Error("Failed to create request")
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
+	var teams []models.Team
+	fetch, err := c.get(ctx, "TeamsBasic", "/scores/json/TeamsBasic", &teams)
 	if err != nil {
-		log.WithError(err).Error("Failed to execute request")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		log.WithField("status_code", resp.StatusCode).Error("SportsData.io API returned error status")
-		return nil, fmt.Errorf("SportsData.io API returned status code %d", resp.StatusCode)
+		log.WithError(err).Error("Failed to fetch teams")
+		return nil, nil, err
 	}
-
-	var teams []models.Team
-	if err := json.NewDecoder(resp.Body).Decode(&teams); err != nil {
-		log.WithError(err).Error("Failed to decode response")
-		return nil, err
+	if fetch.NotModified {
+		log.Info("Payload unchanged since the last sync")
+		return nil, fetch, nil
 	}
 
 	// Update LastUpdated for all teams
@@ -69,38 +81,49 @@ func (c *Client) GetTeams(ctx context.Context) ([]models.Team, error) {
 	}
 
 	log.WithField("count", len(teams)).Info("Successfully fetched teams from API")
-	return teams, nil
+	return teams, fetch, nil
 }
 
-// GetPlayers retrieves all NFL players
-func (c *Client) GetPlayers(ctx context.Context) ([]models.Player, error) {
-	log := logger.WithRequestContext(ctx).WithField("component", "sportsdata_client.GetPlayers")
-	log.Info("Fetching players from SportsData.io API")
-
-	url := fmt.Sprintf("%s/scores/json/PlayersByAvailable?key=%s", c.baseURL, c.apiKey)
+// GetStadiums retrieves all NFL stadiums
+func (c *Client) GetStadiums(ctx context.Context) ([]models.Stadium, *Fetch, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "sportsdata_client.GetStadiums")
+	log.Info("Fetching stadiums from SportsData.io API")
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	var stadiums []models.Stadium
+	fetch, err := c.get(ctx, "Stadiums", "/scores/json/Stadiums", &stadiums)
 	if err != nil {
-		log.WithError(err).Error("Failed to create request")
-		return nil, err
+		log.WithError(err).Error("Failed to fetch stadiums")
+		return nil, nil, err
 	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		log.WithError(err).Error("Failed to execute request")
-		return nil, err
+	if fetch.NotModified {
+		log.Info("Payload unchanged since the last sync")
+		return nil, fetch, nil
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		log.WithField("status_code", resp.StatusCode).Error("SportsData.io API returned error status")
-		return nil, fmt.Errorf("SportsData.io API returned status code %d", resp.StatusCode)
+	// Update LastUpdated for all stadiums
+	now := time.Now()
+	for i := range stadiums {
+		stadiums[i].LastUpdated = now
 	}
 
+	log.WithField("count", len(stadiums)).Info("Successfully fetched stadiums from API")
+	return stadiums, fetch, nil
+}
+
+// GetPlayers retrieves all NFL players
+func (c *Client) GetPlayers(ctx context.Context) ([]models.Player, *Fetch, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "sportsdata_client.GetPlayers")
+	log.Info("Fetching players from SportsData.io API")
+
 	var players []models.Player
-	if err := json.NewDecoder(resp.Body).Decode(&players); err != nil {
-		log.WithError(err).Error("Failed to decode response")
-		return nil, err
+	fetch, err := c.get(ctx, "PlayersByAvailable", "/scores/json/PlayersByAvailable", &players)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch players")
+		return nil, nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Payload unchanged since the last sync")
+		return nil, fetch, nil
 	}
 
 	// Update LastUpdated for all players
@@ -110,41 +133,26 @@ func (c *Client) GetPlayers(ctx context.Context) ([]models.Player, error) {
 	}
 
 	log.WithField("count", len(players)).Info("Successfully fetched players from API")
-	return players, nil
+	return players, fetch, nil
 }
 
 // GetStandings retrieves NFL standings for a specified season
-func (c *Client) GetStandings(ctx context.Context, season string) ([]models.Standing, error) {
+func (c *Client) GetStandings(ctx context.Context, season string) ([]models.Standing, *Fetch, error) {
 	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
 		"component": "sportsdata_client.GetStandings",
 		"season":    season,
 	})
 	log.Info("Fetching standings from SportsData.io API")
 
-	url := fmt.Sprintf("%s/scores/json/Standings/%s?key=%s", c.baseURL, season, c.apiKey)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		log.WithError(err).Error("Failed to create request")
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
+	var standings []models.Standing
+	fetch, err := c.get(ctx, "Standings", "/scores/json/Standings/"+season, &standings)
 	if err != nil {
-		log.WithError(err).Error("Failed to execute request")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		log.WithField("status_code", resp.StatusCode).Error("SportsData.io API returned error status")
-		return nil, fmt.Errorf("SportsData.io API returned status code %d", resp.StatusCode)
+		log.WithError(err).Error("Failed to fetch standings")
+		return nil, nil, err
 	}
-
-	var standings []models.Standing
-	if err := json.NewDecoder(resp.Body).Decode(&standings); err != nil {
-		log.WithError(err).Error("Failed to decode response")
-		return nil, err
+	if fetch.NotModified {
+		log.Info("Payload unchanged since the last sync")
+		return nil, fetch, nil
 	}
 
 	// Update LastUpdated for all standings
@@ -154,41 +162,26 @@ func (c *Client) GetStandings(ctx context.Context, season string) ([]models.Stan
 	}
 
 	log.WithField("count", len(standings)).Info("Successfully fetched standings from API")
-	return standings, nil
+	return standings, fetch, nil
 }
 
 // GetSchedules retrieves NFL schedules for a specified season
-func (c *Client) GetSchedules(ctx context.Context, season string) ([]models.Schedule, error) {
+func (c *Client) GetSchedules(ctx context.Context, season string) ([]models.Schedule, *Fetch, error) {
 	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
 		"component": "sportsdata_client.GetSchedules",
 		"season":    season,
 	})
 	log.Info("Fetching schedules from SportsData.io API")
 
-	url := fmt.Sprintf("%s/scores/json/Schedules/%s?key=%s", c.baseURL, season, c.apiKey)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		log.WithError(err).Error("Failed to create request")
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
+	var schedules []models.Schedule
+	fetch, err := c.get(ctx, "Schedules", "/scores/json/Schedules/"+season, &schedules)
 	if err != nil {
-		log.WithError(err).Error("Failed to execute request")
-		return nil, err
+		log.WithError(err).Error("Failed to fetch schedules")
+		return nil, nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		log.WithField("status_code", resp.StatusCode).Error("SportsData.io API returned error status")
-		return nil, fmt.Errorf("SportsData.io API returned status code %d", resp.StatusCode)
-	}
-
-	var schedules []models.Schedule
-	if err := json.NewDecoder(resp.Body).Decode(&schedules); err != nil {
-		log.WithError(err).Error("Failed to decode response")
-		return nil, err
+	if fetch.NotModified {
+		log.Info("Payload unchanged since the last sync")
+		return nil, fetch, nil
 	}
 
 	// Update LastUpdated for all schedules
@@ -198,41 +191,56 @@ func (c *Client) GetSchedules(ctx context.Context, season string) ([]models.Sche
 	}
 
 	log.WithField("count", len(schedules)).Info("Successfully fetched schedules from API")
-	return schedules, nil
+	return schedules, fetch, nil
 }
 
 // GetGames retrieves NFL games for a specified season (final scores)
-func (c *Client) GetGames(ctx context.Context, season string) ([]models.Game, error) {
+func (c *Client) GetGames(ctx context.Context, season string) ([]models.Game, *Fetch, error) {
 	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
 		"component": "sportsdata_client.GetGames",
 		"season":    season,
 	})
 	log.Info("Fetching games from SportsData.io API")
 
-	url := fmt.Sprintf("%s/stats/json/ScoresFinal/%s?key=%s", c.baseURL, season, c.apiKey)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	var games []models.Game
+	fetch, err := c.get(ctx, "ScoresFinal", "/stats/json/ScoresFinal/"+season, &games)
 	if err != nil {
-		log.WithError(err).Error("Failed to create request")
-		return nil, err
+		log.WithError(err).Error("Failed to fetch games")
+		return nil, nil, err
 	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		log.WithError(err).Error("Failed to execute request")
-		return nil, err
+	if fetch.NotModified {
+		log.Info("Payload unchanged since the last sync")
+		return nil, fetch, nil
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		log.WithField("status_code", resp.StatusCode).Error("SportsData.io API returned error status")
-		return nil, fmt.Errorf("SportsData.io API returned status code %d", resp.StatusCode)
+	// Update LastUpdated for all games
+	now := time.Now()
+	for i := range games {
+		games[i].LastUpdated = now
 	}
 
+	log.WithField("count", len(games)).Info("Successfully fetched games from API")
+	return games, fetch, nil
+}
+
+// GetScoresByWeek retrieves NFL games for a week, including in-progress game state
+func (c *Client) GetScoresByWeek(ctx context.Context, season string, week int) ([]models.Game, *Fetch, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_client.GetScoresByWeek",
+		"season":    season,
+		"week":      week,
+	})
+	log.Info("Fetching scores by week from SportsData.io API")
+
 	var games []models.Game
-	if err := json.NewDecoder(resp.Body).Decode(&games); err != nil {
-		log.WithError(err).Error("Failed to decode response")
-		return nil, err
+	fetch, err := c.get(ctx, "ScoresByWeek", fmt.Sprintf("/scores/json/ScoresByWeek/%s/%d", season, week), &games)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch scores by week")
+		return nil, nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Payload unchanged since the last sync")
+		return nil, fetch, nil
 	}
 
 	// Update LastUpdated for all games
@@ -241,6 +249,308 @@ func (c *Client) GetGames(ctx context.Context, season string) ([]models.Game, er
 		games[i].LastUpdated = now
 	}
 
-	log.WithField("count", len(games)).Info("Successfully fetched games from API")
-	return games, nil
+	log.WithField("count", len(games)).Info("Successfully fetched scores from API")
+	return games, fetch, nil
+}
+
+// GetPlayerGameStatsByWeek retrieves the box score stats of every player for a week
+func (c *Client) GetPlayerGameStatsByWeek(ctx context.Context, season string, week int) ([]models.PlayerGameStats, *Fetch, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_client.GetPlayerGameStatsByWeek",
+		"season":    season,
+		"week":      week,
+	})
+	log.Info("Fetching player game stats by week from SportsData.io API")
+
+	var stats []models.PlayerGameStats
+	fetch, err := c.get(ctx, "PlayerGameStatsByWeek", fmt.Sprintf("/stats/json/PlayerGameStatsByWeek/%s/%d", season, week), &stats)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch player game stats by week")
+		return nil, nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Payload unchanged since the last sync")
+		return nil, fetch, nil
+	}
+
+	// Update LastUpdated for all player game stats
+	now := time.Now()
+	for i := range stats {
+		stats[i].LastUpdated = now
+	}
+
+	log.WithField("count", len(stats)).Info("Successfully fetched player game stats from API")
+	return stats, fetch, nil
+}
+
+// GetTeamGameStatsByWeek retrieves the statistics of every team for a week
+func (c *Client) GetTeamGameStatsByWeek(ctx context.Context, season string, week int) ([]models.TeamGameStats, *Fetch, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_client.GetTeamGameStatsByWeek",
+		"season":    season,
+		"week":      week,
+	})
+	log.Info("Fetching team game stats by week from SportsData.io API")
+
+	var stats []models.TeamGameStats
+	fetch, err := c.get(ctx, "TeamGameStats", fmt.Sprintf("/scores/json/TeamGameStats/%s/%d", season, week), &stats)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch team game stats by week")
+		return nil, nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Payload unchanged since the last sync")
+		return nil, fetch, nil
+	}
+
+	// Update LastUpdated for all team game stats
+	now := time.Now()
+	for i := range stats {
+		stats[i].LastUpdated = now
+	}
+
+	log.WithField("count", len(stats)).Info("Successfully fetched team game stats from API")
+	return stats, fetch, nil
+}
+
+// GetTeamSeasonStats retrieves the accumulated statistics of every team for a season
+func (c *Client) GetTeamSeasonStats(ctx context.Context, season string) ([]models.TeamSeasonStats, *Fetch, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_client.GetTeamSeasonStats",
+		"season":    season,
+	})
+	log.Info("Fetching team season stats from SportsData.io API")
+
+	var stats []models.TeamSeasonStats
+	fetch, err := c.get(ctx, "TeamSeasonStats", "/scores/json/TeamSeasonStats/"+season, &stats)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch team season stats")
+		return nil, nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Payload unchanged since the last sync")
+		return nil, fetch, nil
+	}
+
+	// Update LastUpdated for all team season stats
+	now := time.Now()
+	for i := range stats {
+		stats[i].LastUpdated = now
+	}
+
+	log.WithField("count", len(stats)).Info("Successfully fetched team season stats from API")
+	return stats, fetch, nil
+}
+
+// GetInjuriesByWeek retrieves the injury report of every team for a week
+func (c *Client) GetInjuriesByWeek(ctx context.Context, season string, week int) ([]models.Injury, *Fetch, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_client.GetInjuriesByWeek",
+		"season":    season,
+		"week":      week,
+	})
+	log.Info("Fetching injuries by week from SportsData.io API")
+
+	var injuries []models.Injury
+	fetch, err := c.get(ctx, "Injuries", fmt.Sprintf("/scores/json/Injuries/%s/%d", season, week), &injuries)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch injuries by week")
+		return nil, nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Payload unchanged since the last sync")
+		return nil, fetch, nil
+	}
+
+	// Update LastUpdated for all injuries
+	now := time.Now()
+	for i := range injuries {
+		injuries[i].LastUpdated = now
+	}
+
+	log.WithField("count", len(injuries)).Info("Successfully fetched injuries from API")
+	return injuries, fetch, nil
+}
+
+// teamDepthChart is the SportsData.io depth chart payload of one team
+type teamDepthChart struct {
+	TeamID       int               `json:"TeamID"`
+	Offense      []depthChartEntry `json:"Offense"`
+	Defense      []depthChartEntry `json:"Defense"`
+	SpecialTeams []depthChartEntry `json:"SpecialTeams"`
+}
+
+type depthChartEntry struct {
+	TeamID           int    `json:"TeamID"`
+	PlayerID         int    `json:"PlayerID"`
+	Name             string `json:"Name"`
+	PositionCategory string `json:"PositionCategory"`
+	Position         string `json:"Position"`
+	DepthOrder       int    `json:"DepthOrder"`
+}
+
+// GetDepthCharts retrieves the depth charts of all teams, one per team and position.
+// The returned charts have TeamID set but not Team.
+func (c *Client) GetDepthCharts(ctx context.Context) ([]models.DepthChart, *Fetch, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "sportsdata_client.GetDepthCharts")
+	log.Info("Fetching depth charts from SportsData.io API")
+
+	var teams []teamDepthChart
+	fetch, err := c.get(ctx, "DepthCharts", "/scores/json/DepthCharts", &teams)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch depth charts")
+		return nil, nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Payload unchanged since the last sync")
+		return nil, fetch, nil
+	}
+
+	// Group each team's entries by position, in depth order
+	now := time.Now()
+	charts := []models.DepthChart{}
+	for _, team := range teams {
+		byPosition := map[string]int{}
+		for _, group := range [][]depthChartEntry{team.Offense, team.Defense, team.SpecialTeams} {
+			for _, entry := range group {
+				i, ok := byPosition[entry.Position]
+				if !ok {
+					i = len(charts)
+					byPosition[entry.Position] = i
+					charts = append(charts, models.DepthChart{
+						TeamID:           team.TeamID,
+						Position:         entry.Position,
+						PositionCategory: entry.PositionCategory,
+						Players:          []models.DepthChartEntry{},
+						LastUpdated:      now,
+					})
+				}
+				charts[i].Players = append(charts[i].Players, models.DepthChartEntry{
+					PlayerID:   entry.PlayerID,
+					Name:       entry.Name,
+					DepthOrder: entry.DepthOrder,
+				})
+			}
+		}
+	}
+	for i := range charts {
+		sort.SliceStable(charts[i].Players, func(a, b int) bool {
+			return charts[i].Players[a].DepthOrder < charts[i].Players[b].DepthOrder
+		})
+	}
+
+	log.WithField("count", len(charts)).Info("Successfully fetched depth charts from API")
+	return charts, fetch, nil
+}
+
+// playByPlay is the SportsData.io play-by-play payload of one game
+type playByPlay struct {
+	Score struct {
+		GameKey string `json:"GameKey"`
+		ScoreID int    `json:"ScoreID"`
+	} `json:"Score"`
+	Plays []playByPlayPlay `json:"Plays"`
+}
+
+type playByPlayPlay struct {
+	PlayID               int    `json:"PlayID"`
+	QuarterName          string `json:"QuarterName"`
+	Sequence             int    `json:"Sequence"`
+	TimeRemainingMinutes int    `json:"TimeRemainingMinutes"`
+	TimeRemainingSeconds int    `json:"TimeRemainingSeconds"`
+	Team                 string `json:"Team"`
+	Opponent             string `json:"Opponent"`
+	Down                 int    `json:"Down"`
+	Distance             int    `json:"Distance"`
+	YardLine             int    `json:"YardLine"`
+	YardLineTerritory    string `json:"YardLineTerritory"`
+	YardsToEndZone       int    `json:"YardsToEndZone"`
+	Type                 string `json:"Type"`
+	YardsGained          int    `json:"YardsGained"`
+	Description          string `json:"Description"`
+	IsScoringPlay        bool   `json:"IsScoringPlay"`
+}
+
+// GetPlayByPlay retrieves the plays of a game, in sequence order
+func (c *Client) GetPlayByPlay(ctx context.Context, scoreID int) ([]models.Play, *Fetch, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_client.GetPlayByPlay",
+		"score_id":  scoreID,
+	})
+	log.Info("Fetching play-by-play from SportsData.io API")
+
+	var pbp playByPlay
+	fetch, err := c.get(ctx, "PlayByPlay", fmt.Sprintf("/pbp/json/PlayByPlay/%d", scoreID), &pbp)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch play-by-play")
+		return nil, nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Payload unchanged since the last sync")
+		return nil, fetch, nil
+	}
+
+	now := time.Now()
+	plays := make([]models.Play, 0, len(pbp.Plays))
+	for _, play := range pbp.Plays {
+		plays = append(plays, models.Play{
+			PlayID:               play.PlayID,
+			GameKey:              pbp.Score.GameKey,
+			ScoreID:              scoreID,
+			Sequence:             play.Sequence,
+			Quarter:              play.QuarterName,
+			TimeRemainingMinutes: play.TimeRemainingMinutes,
+			TimeRemainingSeconds: play.TimeRemainingSeconds,
+			Team:                 play.Team,
+			Opponent:             play.Opponent,
+			Down:                 play.Down,
+			Distance:             play.Distance,
+			YardLine:             play.YardLine,
+			YardLineTerritory:    play.YardLineTerritory,
+			YardsToEndZone:       play.YardsToEndZone,
+			Type:                 play.Type,
+			YardsGained:          play.YardsGained,
+			Description:          play.Description,
+			IsScoringPlay:        play.IsScoringPlay,
+			LastUpdated:          now,
+		})
+	}
+	sort.SliceStable(plays, func(a, b int) bool {
+		return plays[a].Sequence < plays[b].Sequence
+	})
+
+	log.WithField("count", len(plays)).Info("Successfully fetched play-by-play from API")
+	return plays, fetch, nil
+}
+
+// SeasonCode formats a season year and SportsData.io season type (1=REG, 2=PRE, 3=POST) as used in API paths, e.g. "2023REG"
+func SeasonCode(season int, seasonType int) string {
+	switch seasonType {
+	case 2:
+		return fmt.Sprintf("%dPRE", season)
+	case 3:
+		return fmt.Sprintf("%dPOST", season)
+	default:
+		return fmt.Sprintf("%dREG", season)
+	}
+}
+
+// ParseSeasonCode is the inverse of SeasonCode. A bare year is the regular season.
+func ParseSeasonCode(code string) (season int, seasonType int, err error) {
+	seasonType = 1
+	year := code
+	switch {
+	case strings.HasSuffix(code, "PRE"):
+		seasonType, year = 2, strings.TrimSuffix(code, "PRE")
+	case strings.HasSuffix(code, "POST"):
+		seasonType, year = 3, strings.TrimSuffix(code, "POST")
+	case strings.HasSuffix(code, "REG"):
+		year = strings.TrimSuffix(code, "REG")
+	}
+
+	season, err = strconv.Atoi(year)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid season %q", code)
+	}
+	return season, seasonType, nil
 }
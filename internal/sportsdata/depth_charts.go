@@ -0,0 +1,173 @@
+package sportsdata
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// SyncDepthCharts fetches depth charts from SportsData.io API and stores them in the database.
+// Every chart that is new or changed is also stored as a snapshot, and the promotions, demotions,
+// additions and removals since the previous snapshot are recorded as changes.
+func (s *Service) SyncDepthCharts(ctx context.Context) (*models.SyncResult, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "sportsdata_service.SyncDepthCharts")
+	log.Info("Syncing depth charts from SportsData.io API to database")
+
+	charts, fetch, err := s.client.GetDepthCharts(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch depth charts from API")
+		return nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Depth charts unchanged, skipped")
+		return skippedSyncResult(EntityDepthCharts), nil
+	}
+
+	// The payload identifies teams by TeamID only
+	teams, err := s.teamsRepo.FindAll(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to load teams")
+		return nil, err
+	}
+	teamKeys := make(map[int]string, len(teams))
+	for _, team := range teams {
+		teamKeys[team.TeamID] = team.Key
+	}
+
+	stored, err := s.depthChartsRepo.FindAll(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to load stored depth charts")
+		return nil, err
+	}
+	storedByKey := make(map[string]*models.DepthChart, len(stored))
+	for i := range stored {
+		storedByKey[depthChartKey(&stored[i])] = &stored[i]
+	}
+
+	log.WithField("count", len(charts)).Info("Upserting depth charts in database")
+
+	// Unchanged charts keep their LastUpdated so that their write is a no-op
+	changed := make([]bool, len(charts))
+	for i := range charts {
+		charts[i].Team = teamKeys[charts[i].TeamID]
+		if charts[i].Team == "" {
+			log.WithField("team_id", charts[i].TeamID).Warn("Depth chart of unknown team, sync teams first")
+		}
+		if old := storedByKey[depthChartKey(&charts[i])]; old != nil && unchanged(old, &charts[i]) {
+			charts[i].LastUpdated = old.LastUpdated
+			continue
+		}
+		changed[i] = true
+	}
+
+	result, err := s.depthChartsRepo.BulkUpsertByTeamAndPosition(ctx, charts)
+	if err != nil {
+		log.WithError(err).Error("Failed to upsert depth charts")
+		return nil, err
+	}
+
+	takenAt := time.Now()
+	snapshots := []models.DepthChartSnapshot{}
+	changes := []models.DepthChartChange{}
+	for i := range charts {
+		if err, failed := result.Errors[i]; failed {
+			log.WithFields(logrus.Fields{
+				"team":     charts[i].Team,
+				"position": charts[i].Position,
+				"error":    err.Error(),
+			}).Error("Failed to upsert depth chart")
+			continue
+		}
+		if !changed[i] {
+			continue
+		}
+		snapshots = append(snapshots, models.DepthChartSnapshot{
+			TeamID:           charts[i].TeamID,
+			Team:             charts[i].Team,
+			Position:         charts[i].Position,
+			PositionCategory: charts[i].PositionCategory,
+			Players:          charts[i].Players,
+			TakenAt:          takenAt,
+		})
+		// The first snapshot of a chart has nothing to compare against
+		if old := storedByKey[depthChartKey(&charts[i])]; old != nil {
+			changes = append(changes, depthChartChanges(old, &charts[i], takenAt)...)
+		}
+	}
+
+	if err := s.depthChartsRepo.InsertSnapshots(ctx, snapshots, changes); err != nil {
+		log.WithError(err).Error("Failed to store depth chart snapshots")
+		return nil, err
+	}
+
+	// Only remember the payload once all of it is stored, so a partial failure is retried next time
+	if result.Failed == 0 {
+		fetch.Commit(ctx)
+	}
+
+	syncResult := newSyncResult(EntityDepthCharts, len(charts), result)
+	log.WithFields(logrus.Fields{
+		"success_count":   syncResult.SuccessCount,
+		"total_count":     syncResult.TotalCount,
+		"inserted_count":  syncResult.InsertedCount,
+		"modified_count":  syncResult.ModifiedCount,
+		"unchanged_count": syncResult.UnchangedCount,
+		"snapshots":       len(snapshots),
+		"changes":         len(changes),
+	}).Info("Depth charts sync completed")
+
+	return syncResult, nil
+}
+
+// depthChartChanges lists the players that moved up, moved down, joined or left a chart
+func depthChartChanges(old *models.DepthChart, chart *models.DepthChart, detectedAt time.Time) []models.DepthChartChange {
+	oldDepth := make(map[int]int, len(old.Players))
+	for _, entry := range old.Players {
+		oldDepth[entry.PlayerID] = entry.DepthOrder
+	}
+
+	change := func(entry models.DepthChartEntry, changeType string, from int, to int) models.DepthChartChange {
+		return models.DepthChartChange{
+			Team:       chart.Team,
+			Position:   chart.Position,
+			PlayerID:   entry.PlayerID,
+			Name:       entry.Name,
+			Type:       changeType,
+			FromDepth:  from,
+			ToDepth:    to,
+			DetectedAt: detectedAt,
+		}
+	}
+
+	changes := []models.DepthChartChange{}
+	current := make(map[int]bool, len(chart.Players))
+	for _, entry := range chart.Players {
+		current[entry.PlayerID] = true
+		from, ok := oldDepth[entry.PlayerID]
+		switch {
+		case !ok:
+			changes = append(changes, change(entry, models.DepthChartAdded, 0, entry.DepthOrder))
+		case entry.DepthOrder < from:
+			changes = append(changes, change(entry, models.DepthChartPromoted, from, entry.DepthOrder))
+		case entry.DepthOrder > from:
+			changes = append(changes, change(entry, models.DepthChartDemoted, from, entry.DepthOrder))
+		}
+	}
+	for _, entry := range old.Players {
+		if !current[entry.PlayerID] {
+			changes = append(changes, change(entry, models.DepthChartRemoved, entry.DepthOrder, 0))
+		}
+	}
+
+	return changes
+}
+
+// depthChartKey identifies a team's chart at one position
+func depthChartKey(chart *models.DepthChart) string {
+	return strconv.Itoa(chart.TeamID) + "/" + chart.Position
+}
@@ -0,0 +1,44 @@
+package sportsdata
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Categories of SportsData.io request failures; test with errors.Is
+var (
+	ErrUnauthorized   = errors.New("SportsData.io authentication failed")
+	ErrQuotaExhausted = errors.New("SportsData.io quota or rate limit exhausted")
+	ErrNotFound       = errors.New("SportsData.io resource not found")
+	ErrUpstream       = errors.New("SportsData.io upstream error")
+)
+
+// APIError describes a failed SportsData.io request. It unwraps to one of the Err* categories.
+type APIError struct {
+	Endpoint   string
+	StatusCode int
+	Attempts   int
+	Kind       error
+	Err        error
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s: %s", e.Kind, e.Endpoint)
+	if e.StatusCode != 0 {
+		msg += fmt.Sprintf(" returned status code %d", e.StatusCode)
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	if e.Attempts > 1 {
+		msg += fmt.Sprintf(" after %d attempts", e.Attempts)
+	}
+	return msg
+}
+
+func (e *APIError) Unwrap() []error {
+	if e.Err != nil {
+		return []error{e.Kind, e.Err}
+	}
+	return []error{e.Kind}
+}
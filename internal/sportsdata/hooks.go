@@ -0,0 +1,55 @@
+package sportsdata
+
+import (
+	"context"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// GamesSyncedHook receives the games a sync inserted or changed
+type GamesSyncedHook func(ctx context.Context, games []models.Game) error
+
+// SchedulesSyncedHook receives the schedules a sync inserted or changed
+type SchedulesSyncedHook func(ctx context.Context, schedules []models.Schedule) error
+
+// OnGamesSynced registers a hook that runs after every games sync that stored new or changed games,
+// including live score polls. Hooks run in registration order and a failing hook doesn't fail the sync.
+// Register hooks before the first sync starts.
+func (s *Service) OnGamesSynced(hook GamesSyncedHook) {
+	s.gamesSyncedHooks = append(s.gamesSyncedHooks, hook)
+}
+
+// gamesSynced runs the registered hooks with the changed games
+func (s *Service) gamesSynced(ctx context.Context, games []models.Game) {
+	if len(games) == 0 {
+		return
+	}
+
+	log := logger.WithRequestContext(ctx).WithField("component", "sportsdata_service.gamesSynced")
+	for _, hook := range s.gamesSyncedHooks {
+		if err := hook(ctx, games); err != nil {
+			log.WithError(err).WithField("games", len(games)).Error("Games synced hook failed")
+		}
+	}
+}
+
+// OnSchedulesSynced registers a hook that runs after every schedules sync that stored new or changed
+// schedules, like OnGamesSynced
+func (s *Service) OnSchedulesSynced(hook SchedulesSyncedHook) {
+	s.schedulesSyncedHooks = append(s.schedulesSyncedHooks, hook)
+}
+
+// schedulesSynced runs the registered hooks with the changed schedules
+func (s *Service) schedulesSynced(ctx context.Context, schedules []models.Schedule) {
+	if len(schedules) == 0 {
+		return
+	}
+
+	log := logger.WithRequestContext(ctx).WithField("component", "sportsdata_service.schedulesSynced")
+	for _, hook := range s.schedulesSyncedHooks {
+		if err := hook(ctx, schedules); err != nil {
+			log.WithError(err).WithField("schedules", len(schedules)).Error("Schedules synced hook failed")
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package sportsdata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// SyncInjuries fetches the injury report of a week from SportsData.io API and stores each player's designation
+// for the week. Designations of earlier weeks are kept, so the collection holds each player's injury history.
+func (s *Service) SyncInjuries(ctx context.Context, season string, week int) (*models.SyncResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_service.SyncInjuries",
+		"season":    season,
+		"week":      week,
+	})
+	log.Info("Syncing injuries from SportsData.io API to database")
+
+	injuries, fetch, err := s.client.GetInjuriesByWeek(ctx, season, week)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch injuries from API")
+		return nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Injuries unchanged, skipped")
+		return skippedSyncResult(EntityInjuries), nil
+	}
+
+	stored, err := s.storedInjuries(ctx, injuries)
+	if err != nil {
+		log.WithError(err).Error("Failed to load stored injuries")
+		return nil, err
+	}
+
+	log.WithField("count", len(injuries)).Info("Upserting injuries in database")
+
+	// Unchanged injuries keep their LastUpdated so that their write is a no-op
+	for i := range injuries {
+		if old := stored[injuryKey(&injuries[i])]; old != nil && unchanged(old, &injuries[i]) {
+			injuries[i].LastUpdated = old.LastUpdated
+		}
+	}
+
+	result, err := s.injuriesRepo.BulkUpsertByPlayerAndWeek(ctx, injuries)
+	if err != nil {
+		log.WithError(err).Error("Failed to upsert injuries")
+		return nil, err
+	}
+
+	for i, err := range result.Errors {
+		log.WithFields(logrus.Fields{
+			"player_id": injuries[i].PlayerID,
+			"team":      injuries[i].Team,
+			"error":     err.Error(),
+		}).Error("Failed to upsert injury")
+	}
+
+	// Only remember the payload once all of it is stored, so a partial failure is retried next time
+	if result.Failed == 0 {
+		fetch.Commit(ctx)
+	}
+
+	syncResult := newSyncResult(EntityInjuries, len(injuries), result)
+	log.WithFields(logrus.Fields{
+		"success_count":   syncResult.SuccessCount,
+		"total_count":     syncResult.TotalCount,
+		"inserted_count":  syncResult.InsertedCount,
+		"modified_count":  syncResult.ModifiedCount,
+		"unchanged_count": syncResult.UnchangedCount,
+	}).Info("Injuries sync completed")
+
+	return syncResult, nil
+}
+
+// storedInjuries loads the stored versions of the given injuries keyed by injuryKey
+func (s *Service) storedInjuries(ctx context.Context, injuries []models.Injury) (map[string]*models.Injury, error) {
+	storedByKey := map[string]*models.Injury{}
+	seen := map[[3]int]bool{}
+	for _, injury := range injuries {
+		week := [3]int{injury.Season, injury.SeasonType, injury.Week}
+		if seen[week] {
+			continue
+		}
+		seen[week] = true
+
+		stored, err := s.injuriesRepo.FindByWeek(ctx, injury.Season, injury.SeasonType, injury.Week)
+		if err != nil {
+			return nil, err
+		}
+		for i := range stored {
+			storedByKey[injuryKey(&stored[i])] = &stored[i]
+		}
+	}
+	return storedByKey, nil
+}
+
+// injuryKey identifies a player's designation for one week
+func injuryKey(injury *models.Injury) string {
+	return fmt.Sprintf("%d/%d/%d/%d", injury.PlayerID, injury.Season, injury.SeasonType, injury.Week)
+}
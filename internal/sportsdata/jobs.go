@@ -0,0 +1,199 @@
+package sportsdata
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// syncEntities lists the entities SyncEntity accepts and whether each one is synced per week
+var syncEntities = map[string]bool{
+	EntityStadiums:        false,
+	EntityTeams:           false,
+	EntityPlayers:         false,
+	EntityStandings:       false,
+	EntitySchedules:       false,
+	EntityGames:           false,
+	EntityTeamSeasonStats: false,
+	EntityDepthCharts:     false,
+	EntityPlayerGameStats: true,
+	EntityTeamGameStats:   true,
+	EntityInjuries:        true,
+	EntityPlayByPlay:      true,
+}
+
+// IsSyncEntity reports whether entity is a name SyncEntity accepts
+func IsSyncEntity(entity string) bool {
+	_, ok := syncEntities[entity]
+	return ok
+}
+
+// IsWeeklyEntity reports whether entity is synced one week at a time
+func IsWeeklyEntity(entity string) bool {
+	return syncEntities[entity]
+}
+
+// StartSyncJob records a new sync job for the season and runs it in the background. An empty entity
+// list runs SyncAll; week is only used by weekly entities. With force, payloads are synced even if they
+// are unchanged since the last sync.
+// The returned job is a snapshot taken before the sync starts; poll the sync_jobs collection for progress.
+func (s *Service) StartSyncJob(ctx context.Context, season string, week int, force bool, entities ...string) (*models.SyncJob, error) {
+	job, err := s.createSyncJob(ctx, season, week, models.SyncTriggerManual, entities, force)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := *job
+
+	// The request context is cancelled as soon as the response is written,
+	// so the job keeps its values (request ID etc.) but not its cancellation.
+	go s.runSyncJob(context.WithoutCancel(ctx), job)
+
+	return &snapshot, nil
+}
+
+// RunSyncJob records a sync job for the given entities and runs it synchronously.
+// An empty entity list syncs everything, like SyncAll.
+func (s *Service) RunSyncJob(ctx context.Context, season string, trigger string, entities ...string) (*models.SyncJob, error) {
+	job, err := s.createSyncJob(ctx, season, 0, trigger, entities, false)
+	if err != nil {
+		return nil, err
+	}
+
+	s.runSyncJob(ctx, job)
+
+	if job.Status == models.SyncJobStatusFailed {
+		return job, fmt.Errorf("sync job %s failed: %s", job.JobID, job.Error)
+	}
+	return job, nil
+}
+
+// SyncEntity runs the sync for a single entity by name. Weekly entities require a week.
+func (s *Service) SyncEntity(ctx context.Context, entity string, season string, week int) (*models.SyncResult, error) {
+	if IsWeeklyEntity(entity) && week < 1 {
+		return nil, fmt.Errorf("sync entity %q requires a week", entity)
+	}
+
+	switch entity {
+	case EntityStadiums:
+		return s.SyncStadiums(ctx)
+	case EntityTeams:
+		return s.SyncTeams(ctx)
+	case EntityPlayers:
+		return s.SyncPlayers(ctx)
+	case EntityStandings:
+		return s.SyncStandings(ctx, season)
+	case EntitySchedules:
+		return s.SyncSchedules(ctx, season)
+	case EntityGames:
+		return s.SyncGames(ctx, season)
+	case EntityDepthCharts:
+		return s.SyncDepthCharts(ctx)
+	case EntityTeamSeasonStats:
+		return s.SyncTeamSeasonStats(ctx, season)
+	case EntityPlayerGameStats:
+		return s.SyncPlayerGameStats(ctx, season, week)
+	case EntityTeamGameStats:
+		return s.SyncTeamGameStats(ctx, season, week)
+	case EntityInjuries:
+		return s.SyncInjuries(ctx, season, week)
+	case EntityPlayByPlay:
+		return s.SyncPlayByPlay(ctx, season, week)
+	default:
+		return nil, fmt.Errorf("unknown sync entity %q", entity)
+	}
+}
+
+// createSyncJob stores a new running sync job
+func (s *Service) createSyncJob(ctx context.Context, season string, week int, trigger string, entities []string, force bool) (*models.SyncJob, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_service.createSyncJob",
+		"season":    season,
+		"week":      week,
+		"trigger":   trigger,
+		"entities":  entities,
+		"force":     force,
+	})
+
+	if entities == nil {
+		entities = []string{}
+	}
+
+	job := &models.SyncJob{
+		JobID:     uuid.New().String(),
+		Season:    season,
+		Week:      week,
+		Trigger:   trigger,
+		Force:     force,
+		Entities:  entities,
+		Status:    models.SyncJobStatusRunning,
+		Results:   []models.SyncResult{},
+		StartedAt: time.Now(),
+	}
+
+	if _, err := s.syncJobsRepo.Create(ctx, job); err != nil {
+		log.WithError(err).Error("Failed to record sync job")
+		return nil, err
+	}
+
+	log.WithField("job_id", job.JobID).Info("Sync job started")
+	return job, nil
+}
+
+// runSyncJob executes the job's syncs and stores the outcome
+func (s *Service) runSyncJob(ctx context.Context, job *models.SyncJob) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_service.runSyncJob",
+		"job_id":    job.JobID,
+		"season":    job.Season,
+	})
+
+	if job.Force {
+		ctx = ForceRefresh(ctx)
+	}
+
+	var results []models.SyncResult
+	var err error
+
+	if len(job.Entities) == 0 {
+		results, err = s.SyncAll(ctx, job.Season)
+	} else {
+		results = []models.SyncResult{}
+		for _, entity := range job.Entities {
+			var result *models.SyncResult
+			result, err = s.SyncEntity(ctx, entity, job.Season, job.Week)
+			if err != nil {
+				// Record the failed entity too, so the job shows where it stopped
+				results = append(results, *failedSyncResult(entity, err))
+				break
+			}
+			results = append(results, *result)
+		}
+	}
+
+	finishedAt := time.Now()
+	job.Results = results
+	job.FinishedAt = &finishedAt
+	job.Status = models.SyncJobStatusCompleted
+	if err != nil {
+		job.Status = models.SyncJobStatusFailed
+		job.Error = err.Error()
+	}
+
+	// Record the outcome even if the job itself was cancelled mid-run
+	if _, err := s.syncJobsRepo.Update(context.WithoutCancel(ctx), job); err != nil {
+		log.WithError(err).Error("Failed to record sync job result")
+		return
+	}
+
+	log.WithFields(logrus.Fields{
+		"status":      job.Status,
+		"duration_ms": finishedAt.Sub(job.StartedAt).Milliseconds(),
+	}).Info("Sync job finished")
+}
@@ -0,0 +1,152 @@
+package sportsdata
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// SyncLiveGames fetches in-progress scores for a week and upserts those of the given games whose
+// live state changed. It returns the latest state of the requested games.
+func (s *Service) SyncLiveGames(ctx context.Context, season string, week int, gameKeys []string) ([]models.Game, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_service.SyncLiveGames",
+		"season":    season,
+		"week":      week,
+	})
+	log.Info("Syncing live games from SportsData.io API to database")
+
+	games, fetch, err := s.client.GetScoresByWeek(ctx, season, week)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch live scores from API")
+		return nil, err
+	}
+	if fetch.NotModified {
+		// Nothing changed since the last poll, so the stored games are current
+		log.Info("Live scores unchanged, skipped")
+		current, err := s.gamesRepo.FindByGameKeys(ctx, gameKeys)
+		if err != nil {
+			log.WithError(err).Error("Failed to load stored games")
+			return nil, err
+		}
+		return current, nil
+	}
+
+	storedByKey, err := s.storedGamesByKey(ctx, games)
+	if err != nil {
+		log.WithError(err).Error("Failed to load stored games")
+		return nil, err
+	}
+
+	wanted := make(map[string]bool, len(gameKeys))
+	for _, key := range gameKeys {
+		wanted[key] = true
+	}
+
+	current := []models.Game{}
+	changed := []models.Game{}
+	for _, game := range games {
+		if !wanted[game.GameKey] {
+			continue
+		}
+
+		if old := storedByKey[game.GameKey]; old != nil && !gameChanged(old, &game) {
+			current = append(current, *old)
+			continue
+		}
+		changed = append(changed, game)
+	}
+
+	result, err := s.gamesRepo.BulkUpsertByGameKey(ctx, changed)
+	if err != nil {
+		log.WithError(err).Error("Failed to upsert live games")
+		return nil, err
+	}
+
+	if result.Failed == 0 {
+		fetch.Commit(ctx)
+	}
+
+	stored := []models.Game{}
+	for i := range changed {
+		old := storedByKey[changed[i].GameKey]
+		if err, failed := result.Errors[i]; failed {
+			log.WithFields(logrus.Fields{
+				"game_key": changed[i].GameKey,
+				"teams":    changed[i].AwayTeam + "@" + changed[i].HomeTeam,
+				"error":    err.Error(),
+			}).Error("Failed to upsert live game")
+			if old != nil {
+				current = append(current, *old)
+			}
+			continue
+		}
+		stored = append(stored, changed[i])
+		current = append(current, changed[i])
+		s.publishGameUpdate(old, &changed[i])
+	}
+
+	s.gamesSynced(ctx, stored)
+
+	log.WithFields(logrus.Fields{
+		"changed_count": len(stored),
+		"total_count":   len(current),
+	}).Info("Live games sync completed")
+
+	return current, nil
+}
+
+// storedGamesByKey loads the stored versions of the given games keyed by GameKey
+func (s *Service) storedGamesByKey(ctx context.Context, games []models.Game) (map[string]*models.Game, error) {
+	gameKeys := make([]string, 0, len(games))
+	for _, game := range games {
+		gameKeys = append(gameKeys, game.GameKey)
+	}
+
+	stored, err := s.gamesRepo.FindByGameKeys(ctx, gameKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	storedByKey := make(map[string]*models.Game, len(stored))
+	for i := range stored {
+		storedByKey[stored[i].GameKey] = &stored[i]
+	}
+	return storedByKey, nil
+}
+
+// gameChanged reports whether any score or live game state differs between two versions of a game
+func gameChanged(old *models.Game, new *models.Game) bool {
+	return old.Status != new.Status ||
+		old.AwayScore != new.AwayScore ||
+		old.HomeScore != new.HomeScore ||
+		old.Quarter != new.Quarter ||
+		old.TimeRemaining != new.TimeRemaining ||
+		!equalPtr(old.Possession, new.Possession) ||
+		!equalPtr(old.Down, new.Down) ||
+		!equalPtr(old.Distance, new.Distance) ||
+		!equalPtr(old.YardLine, new.YardLine) ||
+		!equalPtr(old.YardLineTerritory, new.YardLineTerritory) ||
+		old.RedZone != new.RedZone ||
+		old.AwayScoreQuarter1 != new.AwayScoreQuarter1 ||
+		old.AwayScoreQuarter2 != new.AwayScoreQuarter2 ||
+		old.AwayScoreQuarter3 != new.AwayScoreQuarter3 ||
+		old.AwayScoreQuarter4 != new.AwayScoreQuarter4 ||
+		old.AwayScoreOvertime != new.AwayScoreOvertime ||
+		old.HomeScoreQuarter1 != new.HomeScoreQuarter1 ||
+		old.HomeScoreQuarter2 != new.HomeScoreQuarter2 ||
+		old.HomeScoreQuarter3 != new.HomeScoreQuarter3 ||
+		old.HomeScoreQuarter4 != new.HomeScoreQuarter4 ||
+		old.HomeScoreOvertime != new.HomeScoreOvertime
+}
+
+// equalPtr compares two optional values, treating two nils as equal
+func equalPtr[T comparable](a *T, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,101 @@
+package sportsdata
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// EndpointMetrics counts the requests made to one SportsData.io endpoint
+type EndpointMetrics struct {
+	// Calls made by the client, each of which may take several attempts
+	Calls     int64 `json:"calls"`
+	Attempts  int64 `json:"attempts"`
+	Successes int64 `json:"successes"`
+	Failures  int64 `json:"failures"`
+	Retries   int64 `json:"retries"`
+	// Successful calls whose payload was unchanged and skipped
+	NotModified int64 `json:"notModified"`
+	// Responses with status 429
+	RateLimited      int64      `json:"rateLimited"`
+	LastStatusCode   int        `json:"lastStatusCode,omitempty"`
+	LastError        string     `json:"lastError,omitempty"`
+	LastCallAt       *time.Time `json:"lastCallAt,omitempty"`
+	AverageLatencyMs float64    `json:"averageLatencyMs"`
+
+	totalLatency time.Duration
+}
+
+// metrics is safe for concurrent use by the scheduler, live poller and admin syncs
+type metrics struct {
+	mu        sync.Mutex
+	endpoints map[string]*EndpointMetrics
+}
+
+func newMetrics() *metrics {
+	return &metrics{endpoints: map[string]*EndpointMetrics{}}
+}
+
+func (m *metrics) endpoint(name string) *EndpointMetrics {
+	e, ok := m.endpoints[name]
+	if !ok {
+		e = &EndpointMetrics{}
+		m.endpoints[name] = e
+	}
+	return e
+}
+
+// recordAttempt counts one HTTP attempt; statusCode is 0 when no response was received
+func (m *metrics) recordAttempt(name string, statusCode int, latency time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e := m.endpoint(name)
+	e.Attempts++
+	e.totalLatency += latency
+	e.AverageLatencyMs = float64(e.totalLatency) / float64(time.Millisecond) / float64(e.Attempts)
+	e.LastStatusCode = statusCode
+	if statusCode == http.StatusTooManyRequests {
+		e.RateLimited++
+	}
+}
+
+func (m *metrics) recordRetry(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.endpoint(name).Retries++
+}
+
+// recordCall counts a finished call, err being its final outcome
+func (m *metrics) recordCall(name string, err error, notModified bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	e := m.endpoint(name)
+	e.Calls++
+	e.LastCallAt = &now
+	if err != nil {
+		e.Failures++
+		e.LastError = err.Error()
+		return
+	}
+	e.Successes++
+	e.LastError = ""
+	if notModified {
+		e.NotModified++
+	}
+}
+
+// snapshot copies the metrics of every endpoint
+func (m *metrics) snapshot() map[string]EndpointMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := make(map[string]EndpointMetrics, len(m.endpoints))
+	for name, e := range m.endpoints {
+		snapshot[name] = *e
+	}
+	return snapshot
+}
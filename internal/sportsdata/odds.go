@@ -0,0 +1,86 @@
+package sportsdata
+
+import (
+	"context"
+	"time"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+)
+
+// gameOdds is the consensus line of a game that hasn't kicked off, or nil if it has or has no line yet
+func gameOdds(game *models.Game) *models.OddsSnapshot {
+	if game.Status != models.GameStatusScheduled {
+		return nil
+	}
+	return oddsSnapshot(game.GameKey, game.Season, game.SeasonType, game.Week, models.OddsLine{
+		PointSpread:       game.PointSpread,
+		OverUnder:         game.OverUnder,
+		HomeTeamMoneyLine: game.HomeTeamMoneyLine,
+		AwayTeamMoneyLine: game.AwayTeamMoneyLine,
+	})
+}
+
+// scheduleOdds is the consensus line of a scheduled game that hasn't kicked off, or nil if it has or has no line yet
+func scheduleOdds(schedule *models.Schedule) *models.OddsSnapshot {
+	if schedule.Status != models.GameStatusScheduled || schedule.Canceled {
+		return nil
+	}
+	return oddsSnapshot(schedule.GameKey, schedule.Season, schedule.SeasonType, schedule.Week, models.OddsLine{
+		PointSpread:       schedule.PointSpread,
+		OverUnder:         schedule.OverUnder,
+		HomeTeamMoneyLine: schedule.HomeTeamMoneyLine,
+		AwayTeamMoneyLine: schedule.AwayTeamMoneyLine,
+	})
+}
+
+func oddsSnapshot(gameKey string, season int, seasonType int, week int, line models.OddsLine) *models.OddsSnapshot {
+	// A pick'em spread is 0 too, but not together with every other number
+	if line.SameLine(&models.OddsLine{}) {
+		return nil
+	}
+	return &models.OddsSnapshot{
+		GameKey:    gameKey,
+		Season:     season,
+		SeasonType: seasonType,
+		Week:       week,
+		Sportsbook: models.OddsSportsbookConsensus,
+		OddsLine:   line,
+	}
+}
+
+// recordOddsSnapshots stores each of the lines that moved since the game's latest snapshot and returns how many
+// were stored. Lines are only taken before kickoff, so a game's last snapshot is its closing line.
+func (s *Service) recordOddsSnapshots(ctx context.Context, lines []models.OddsSnapshot) (int, error) {
+	if len(lines) == 0 {
+		return 0, nil
+	}
+
+	gameKeys := make([]string, 0, len(lines))
+	for _, line := range lines {
+		gameKeys = append(gameKeys, line.GameKey)
+	}
+
+	latest, err := s.oddsRepo.FindLatestByGameKeys(ctx, gameKeys, models.OddsSportsbookConsensus)
+	if err != nil {
+		return 0, err
+	}
+	latestByKey := make(map[string]*models.OddsSnapshot, len(latest))
+	for i := range latest {
+		latestByKey[latest[i].GameKey] = &latest[i]
+	}
+
+	takenAt := time.Now()
+	moved := []models.OddsSnapshot{}
+	for _, line := range lines {
+		if last := latestByKey[line.GameKey]; last != nil && last.SameLine(&line.OddsLine) {
+			continue
+		}
+		line.TakenAt = takenAt
+		moved = append(moved, line)
+	}
+
+	if err := s.oddsRepo.InsertSnapshots(ctx, moved); err != nil {
+		return 0, err
+	}
+	return len(moved), nil
+}
@@ -0,0 +1,275 @@
+package sportsdata
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// nonDrivePlayTypes are plays that happen between drives rather than as part of one
+var nonDrivePlayTypes = map[string]bool{
+	"Kickoff":            true,
+	"ExtraPoint":         true,
+	"TwoPointConversion": true,
+	"Timeout":            true,
+	"Period":             true,
+	"TwoMinuteWarning":   true,
+}
+
+// SyncPlayByPlay fetches the play-by-play of the week's final games from SportsData.io API and stores
+// the plays along with the drives derived from them. Games whose plays are already stored are skipped
+// unless the sync is forced, since the play-by-play of a final game rarely changes.
+func (s *Service) SyncPlayByPlay(ctx context.Context, season string, week int) (*models.SyncResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_service.SyncPlayByPlay",
+		"season":    season,
+		"week":      week,
+	})
+	log.Info("Syncing play-by-play from SportsData.io API to database")
+
+	seasonYear, seasonType, err := ParseSeasonCode(season)
+	if err != nil {
+		log.WithError(err).Error("Invalid season")
+		return nil, err
+	}
+
+	games, err := s.gamesRepo.FindByWeek(ctx, seasonYear, week)
+	if err != nil {
+		log.WithError(err).Error("Failed to load games of the week")
+		return nil, err
+	}
+
+	syncResult := &models.SyncResult{Entity: EntityPlayByPlay, Skipped: true}
+	for _, game := range games {
+		if game.SeasonType != seasonType || game.ScoreID == 0 {
+			continue
+		}
+		if game.Status != models.GameStatusFinal && game.Status != models.GameStatusFinalOT {
+			continue
+		}
+
+		result, err := s.syncGamePlayByPlay(ctx, &game)
+		if err != nil {
+			return nil, err
+		}
+		if result.Skipped {
+			continue
+		}
+
+		syncResult.Skipped = false
+		syncResult.SuccessCount += result.SuccessCount
+		syncResult.FailureCount += result.FailureCount
+		syncResult.TotalCount += result.TotalCount
+		syncResult.InsertedCount += result.InsertedCount
+		syncResult.ModifiedCount += result.ModifiedCount
+		syncResult.UnchangedCount += result.UnchangedCount
+	}
+
+	log.WithFields(logrus.Fields{
+		"skipped":         syncResult.Skipped,
+		"success_count":   syncResult.SuccessCount,
+		"total_count":     syncResult.TotalCount,
+		"inserted_count":  syncResult.InsertedCount,
+		"modified_count":  syncResult.ModifiedCount,
+		"unchanged_count": syncResult.UnchangedCount,
+	}).Info("Play-by-play sync completed")
+
+	return syncResult, nil
+}
+
+// syncGamePlayByPlay syncs the plays and drives of a single game
+func (s *Service) syncGamePlayByPlay(ctx context.Context, game *models.Game) (*models.SyncResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_service.syncGamePlayByPlay",
+		"game_key":  game.GameKey,
+		"score_id":  game.ScoreID,
+	})
+
+	stored, err := s.playByPlayRepo.FindPlaysByGameKey(ctx, game.GameKey)
+	if err != nil {
+		log.WithError(err).Error("Failed to load stored plays")
+		return nil, err
+	}
+	if len(stored) > 0 && !isForceRefresh(ctx) {
+		log.Info("Plays already stored, skipped")
+		return skippedSyncResult(EntityPlayByPlay), nil
+	}
+
+	plays, fetch, err := s.client.GetPlayByPlay(ctx, game.ScoreID)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch play-by-play from API")
+		return nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Play-by-play unchanged, skipped")
+		return skippedSyncResult(EntityPlayByPlay), nil
+	}
+
+	// The payload's game key wins, but fall back to the stored game's in case it is missing
+	for i := range plays {
+		if plays[i].GameKey == "" {
+			plays[i].GameKey = game.GameKey
+		}
+	}
+
+	now := time.Now()
+	drives := buildDrives(plays)
+	for i := range drives {
+		drives[i].GameKey = game.GameKey
+		drives[i].ScoreID = game.ScoreID
+		drives[i].LastUpdated = now
+	}
+
+	storedByID := make(map[int]*models.Play, len(stored))
+	for i := range stored {
+		storedByID[stored[i].PlayID] = &stored[i]
+	}
+
+	log.WithField("count", len(plays)).Info("Upserting plays in database")
+
+	// Unchanged plays keep their LastUpdated so that their write is a no-op
+	for i := range plays {
+		if old := storedByID[plays[i].PlayID]; old != nil && unchanged(old, &plays[i]) {
+			plays[i].LastUpdated = old.LastUpdated
+		}
+	}
+
+	result, err := s.playByPlayRepo.BulkUpsertPlaysByPlayID(ctx, plays)
+	if err != nil {
+		log.WithError(err).Error("Failed to upsert plays")
+		return nil, err
+	}
+
+	for i, err := range result.Errors {
+		log.WithFields(logrus.Fields{
+			"play_id": plays[i].PlayID,
+			"error":   err.Error(),
+		}).Error("Failed to upsert play")
+	}
+
+	if result.Inserted > 0 || result.Modified > 0 {
+		if err := s.playByPlayRepo.ReplaceDrives(ctx, game.GameKey, drives); err != nil {
+			log.WithError(err).Error("Failed to store drives")
+			return nil, err
+		}
+	}
+
+	// Only remember the payload once all of it is stored, so a partial failure is retried next time
+	if result.Failed == 0 {
+		fetch.Commit(ctx)
+	}
+
+	log.WithField("drives", len(drives)).Info("Game play-by-play synced")
+	return newSyncResult(EntityPlayByPlay, len(plays), result), nil
+}
+
+// buildDrives groups plays in sequence order into drives: a drive is a run of plays by the same team
+// within one half. It sets the DriveNumber of every play that belongs to a drive.
+func buildDrives(plays []models.Play) []models.Drive {
+	drives := []models.Drive{}
+	var last []*models.Play
+	var scoring []*models.Play
+
+	for i := range plays {
+		play := &plays[i]
+		play.DriveNumber = 0
+		if play.Team == "" || nonDrivePlayTypes[play.Type] {
+			continue
+		}
+
+		n := len(drives)
+		if n == 0 || drives[n-1].Team != play.Team || half(drives[n-1].StartQuarter) != half(play.Quarter) {
+			drives = append(drives, models.Drive{
+				Number:              n + 1,
+				Team:                play.Team,
+				StartQuarter:        play.Quarter,
+				StartTime:           clock(play),
+				StartYardsToEndZone: play.YardsToEndZone,
+			})
+			last = append(last, nil)
+			scoring = append(scoring, nil)
+			n++
+		}
+
+		drive := &drives[n-1]
+		drive.Plays++
+		drive.Yards += play.YardsGained
+		drive.EndQuarter = play.Quarter
+		drive.EndTime = clock(play)
+		play.DriveNumber = drive.Number
+		last[n-1] = play
+		if play.IsScoringPlay {
+			scoring[n-1] = play
+		}
+	}
+
+	for i := range drives {
+		var next *models.Drive
+		if i+1 < len(drives) {
+			next = &drives[i+1]
+		}
+		drives[i].Result = driveResult(&drives[i], last[i], scoring[i], next)
+	}
+
+	return drives
+}
+
+// driveResult tells how a drive ended from its last play, its scoring play if any and the drive after it
+func driveResult(drive *models.Drive, last *models.Play, scoring *models.Play, next *models.Drive) string {
+	if scoring != nil {
+		switch scoring.Type {
+		case "FieldGoal":
+			return models.DriveResultFieldGoal
+		case "Safety":
+			return models.DriveResultSafety
+		case "PassIntercepted", "Fumble":
+			return models.DriveResultTurnover
+		default:
+			return models.DriveResultTouchdown
+		}
+	}
+
+	switch last.Type {
+	case "Punt":
+		return models.DriveResultPunt
+	case "FieldGoal":
+		return models.DriveResultMissedFieldGoal
+	case "PassIntercepted", "Fumble":
+		return models.DriveResultTurnover
+	case "Safety":
+		return models.DriveResultSafety
+	}
+
+	switch {
+	case next == nil:
+		return models.DriveResultEndOfGame
+	case half(next.StartQuarter) != half(drive.EndQuarter):
+		return models.DriveResultEndOfHalf
+	case last.Down == 4:
+		return models.DriveResultDowns
+	default:
+		return models.DriveResultUnknown
+	}
+}
+
+// half numbers the halves of a game, with overtime counted as a third
+func half(quarter string) int {
+	switch quarter {
+	case "1", "2":
+		return 1
+	case "3", "4":
+		return 2
+	default:
+		return 3
+	}
+}
+
+// clock formats the time remaining in the quarter at a play
+func clock(play *models.Play) string {
+	return fmt.Sprintf("%d:%02d", play.TimeRemainingMinutes, play.TimeRemainingSeconds)
+}
@@ -0,0 +1,104 @@
+package sportsdata
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// SyncPlayerGameStats fetches the player box scores of a week from SportsData.io API and stores them in the database
+func (s *Service) SyncPlayerGameStats(ctx context.Context, season string, week int) (*models.SyncResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_service.SyncPlayerGameStats",
+		"season":    season,
+		"week":      week,
+	})
+	log.Info("Syncing player game stats from SportsData.io API to database")
+
+	stats, fetch, err := s.client.GetPlayerGameStatsByWeek(ctx, season, week)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch player game stats from API")
+		return nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Player game stats unchanged, skipped")
+		return skippedSyncResult(EntityPlayerGameStats), nil
+	}
+
+	stored, err := s.storedPlayerGameStats(ctx, stats)
+	if err != nil {
+		log.WithError(err).Error("Failed to load stored player game stats")
+		return nil, err
+	}
+
+	log.WithField("count", len(stats)).Info("Upserting player game stats in database")
+
+	// Unchanged stats keep their LastUpdated so that their write is a no-op
+	for i := range stats {
+		if old := stored[playerGameKey(&stats[i])]; old != nil && unchanged(old, &stats[i]) {
+			stats[i].LastUpdated = old.LastUpdated
+		}
+	}
+
+	result, err := s.playerGameStatsRepo.BulkUpsertByPlayerAndGame(ctx, stats)
+	if err != nil {
+		log.WithError(err).Error("Failed to upsert player game stats")
+		return nil, err
+	}
+
+	for i, err := range result.Errors {
+		log.WithFields(logrus.Fields{
+			"player_id": stats[i].PlayerID,
+			"game_key":  stats[i].GameKey,
+			"error":     err.Error(),
+		}).Error("Failed to upsert player game stats")
+	}
+
+	// Only remember the payload once all of it is stored, so a partial failure is retried next time
+	if result.Failed == 0 {
+		fetch.Commit(ctx)
+	}
+
+	syncResult := newSyncResult(EntityPlayerGameStats, len(stats), result)
+	log.WithFields(logrus.Fields{
+		"success_count":   syncResult.SuccessCount,
+		"total_count":     syncResult.TotalCount,
+		"inserted_count":  syncResult.InsertedCount,
+		"modified_count":  syncResult.ModifiedCount,
+		"unchanged_count": syncResult.UnchangedCount,
+	}).Info("Player game stats sync completed")
+
+	return syncResult, nil
+}
+
+// storedPlayerGameStats loads the stored versions of the given stats keyed by playerGameKey
+func (s *Service) storedPlayerGameStats(ctx context.Context, stats []models.PlayerGameStats) (map[string]*models.PlayerGameStats, error) {
+	seen := map[string]bool{}
+	gameKeys := []string{}
+	for _, stat := range stats {
+		if !seen[stat.GameKey] {
+			seen[stat.GameKey] = true
+			gameKeys = append(gameKeys, stat.GameKey)
+		}
+	}
+
+	stored, err := s.playerGameStatsRepo.FindByGameKeys(ctx, gameKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	storedByKey := make(map[string]*models.PlayerGameStats, len(stored))
+	for i := range stored {
+		storedByKey[playerGameKey(&stored[i])] = &stored[i]
+	}
+	return storedByKey, nil
+}
+
+// playerGameKey identifies a player's stats in one game
+func playerGameKey(stats *models.PlayerGameStats) string {
+	return strconv.Itoa(stats.PlayerID) + "/" + stats.GameKey
+}
@@ -0,0 +1,75 @@
+package sportsdata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+)
+
+func TestPlayerGameKey(t *testing.T) {
+	tests := []struct {
+		name string
+		a    models.PlayerGameStats
+		b    models.PlayerGameStats
+		same bool
+	}{
+		{
+			name: "same player and game",
+			a:    models.PlayerGameStats{PlayerID: 19801, GameKey: "202310105", Team: "BUF"},
+			b:    models.PlayerGameStats{PlayerID: 19801, GameKey: "202310105", Team: "MIA"},
+			same: true,
+		},
+		{
+			name: "other game",
+			a:    models.PlayerGameStats{PlayerID: 19801, GameKey: "202310105"},
+			b:    models.PlayerGameStats{PlayerID: 19801, GameKey: "202310205"},
+		},
+		{
+			name: "other player",
+			a:    models.PlayerGameStats{PlayerID: 19801, GameKey: "202310105"},
+			b:    models.PlayerGameStats{PlayerID: 19802, GameKey: "202310105"},
+		},
+		{
+			name: "digits don't run into the game key",
+			a:    models.PlayerGameStats{PlayerID: 1, GameKey: "12023"},
+			b:    models.PlayerGameStats{PlayerID: 11, GameKey: "2023"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if same := playerGameKey(&tt.a) == playerGameKey(&tt.b); same != tt.same {
+				t.Errorf("%q and %q: same = %v, want %v", playerGameKey(&tt.a), playerGameKey(&tt.b), same, tt.same)
+			}
+		})
+	}
+}
+
+func TestPlayerGameStatsUnchanged(t *testing.T) {
+	stored := models.PlayerGameStats{PlayerID: 19801, GameKey: "202310105", PassingYards: 300, LastUpdated: time.Now().Add(-time.Hour)}
+
+	tests := []struct {
+		name      string
+		fetched   models.PlayerGameStats
+		unchanged bool
+	}{
+		{
+			name:      "only the sync time differs",
+			fetched:   models.PlayerGameStats{PlayerID: 19801, GameKey: "202310105", PassingYards: 300, LastUpdated: time.Now()},
+			unchanged: true,
+		},
+		{
+			name:    "a stat changed",
+			fetched: models.PlayerGameStats{PlayerID: 19801, GameKey: "202310105", PassingYards: 312},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := unchanged(&stored, &tt.fetched); got != tt.unchanged {
+				t.Errorf("unchanged = %v, want %v", got, tt.unchanged)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package sportsdata
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/events"
+)
+
+// publishGameUpdate notifies subscribers of the game and of both teams that the game changed
+func (s *Service) publishGameUpdate(old *models.Game, game *models.Game) {
+	s.publish(events.TypeGameUpdated, []string{
+		events.GameTopic(game.GameKey),
+		events.TeamTopic(game.HomeTeam),
+		events.TeamTopic(game.AwayTeam),
+	}, old, *game)
+}
+
+// publishTeamUpdate notifies subscribers of the team that its details changed
+func (s *Service) publishTeamUpdate(old *models.Team, team *models.Team) {
+	s.publish(events.TypeTeamUpdated, []string{
+		events.TeamTopic(team.Key),
+	}, old, *team)
+}
+
+// publishPlayerUpdate notifies subscribers of the player and of the player's team that the player changed
+func (s *Service) publishPlayerUpdate(old *models.Player, player *models.Player) {
+	topics := []string{events.PlayerTopic(player.PlayerID)}
+	if player.Team != "" {
+		topics = append(topics, events.TeamTopic(player.Team))
+	}
+	s.publish(events.TypePlayerUpdated, topics, old, *player)
+}
+
+// publishStandingUpdate notifies subscribers of the division and of the team that the standing changed
+func (s *Service) publishStandingUpdate(old *models.Standing, standing *models.Standing) {
+	s.publish(events.TypeStandingUpdated, []string{
+		events.StandingsTopic(standing.Conference, standing.Division),
+		events.TeamTopic(standing.Team),
+	}, old, *standing)
+}
+
+// publish sends an event with the changed fields of the document, if there are any
+func (s *Service) publish(eventType string, topics []string, old interface{}, document interface{}) {
+	diff, err := events.Diff(old, document)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"component":  "sportsdata_service.publish",
+			"event_type": eventType,
+		}).WithError(err).Error("Failed to compute event diff")
+		return
+	}
+	if len(diff) == 0 {
+		return
+	}
+
+	s.broker.Publish(eventType, topics, document, diff)
+}
+
+// standingKey identifies a standing the same way UpsertByTeamAndSeason does
+func standingKey(standing *models.Standing) string {
+	return fmt.Sprintf("%s/%d", standing.Team, standing.Season)
+}
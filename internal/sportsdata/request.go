@@ -0,0 +1,242 @@
+package sportsdata
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// maxErrorBodySize caps how much of an error response body is kept for the error message
+const maxErrorBodySize = 512
+
+// get requests a SportsData.io path and decodes the JSON response into out. Rate-limited (429),
+// 5xx and network failures are retried with exponential backoff; other failures return an *APIError at once.
+// When the payload matches the last one synced for the URL, out is left untouched and the Fetch is NotModified.
+// endpoint names the API method for logs and metrics, e.g. "TeamsBasic".
+func (c *Client) get(ctx context.Context, endpoint string, path string, out interface{}) (*Fetch, error) {
+	fetch, err := c.execute(ctx, endpoint, path, out)
+	c.metrics.recordCall(endpoint, err, fetch != nil && fetch.NotModified)
+	return fetch, err
+}
+
+func (c *Client) execute(ctx context.Context, endpoint string, path string, out interface{}) (*Fetch, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_client.execute",
+		"endpoint":  endpoint,
+	})
+
+	var cached *models.ResponseCache
+	if !isForceRefresh(ctx) {
+		var err error
+		cached, err = c.cacheRepo.FindByURL(ctx, c.baseURL+path)
+		if err != nil {
+			log.WithError(err).Warn("Failed to load response validators, requesting without them")
+			cached = nil
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		// Wait for a token so that bursts of syncs stay within the plan's rate limit
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		start := time.Now()
+		result, err := c.attempt(ctx, path, cached, out)
+		c.metrics.recordAttempt(endpoint, result.statusCode, time.Since(start))
+		if err == nil {
+			return &Fetch{NotModified: result.notModified, client: c, entry: result.entry}, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		statusCode, retryAfter := result.statusCode, result.retryAfter
+
+		kind, retryable := classifyFailure(statusCode, err)
+		apiErr := &APIError{
+			Endpoint:   endpoint,
+			StatusCode: statusCode,
+			Attempts:   attempt,
+			Kind:       kind,
+			Err:        err,
+		}
+
+		if !retryable || attempt > c.maxRetries {
+			log.WithError(apiErr).Error("SportsData.io request failed")
+			return nil, apiErr
+		}
+
+		// A Retry-After longer than we are willing to wait means the quota won't recover in time
+		if retryAfter > c.retryMaxDelay {
+			log.WithField("retry_after", retryAfter).WithError(apiErr).Error("SportsData.io request failed, Retry-After exceeds the maximum delay")
+			return nil, apiErr
+		}
+
+		wait := max(c.backoff(attempt), retryAfter)
+		log.WithFields(logrus.Fields{
+			"status_code": statusCode,
+			"attempt":     attempt,
+			"wait":        wait,
+		}).WithError(err).Warn("SportsData.io request failed, retrying")
+		c.metrics.recordRetry(endpoint)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// attemptResult describes the response to a single request attempt
+type attemptResult struct {
+	// statusCode is 0 if no response was received
+	statusCode int
+	retryAfter time.Duration
+	// notModified is set on a 304 or when the body hashes the same as the cached one
+	notModified bool
+	// entry holds the validators of a changed response, to be stored once it is synced
+	entry *models.ResponseCache
+}
+
+// attempt performs a single request, made conditional on the cached validators if there are any
+func (c *Client) attempt(ctx context.Context, path string, cached *models.ResponseCache, out interface{}) (attemptResult, error) {
+	// The API key is only added here so that it never ends up in logs, errors or the cache
+	requestURL := fmt.Sprintf("%s%s?key=%s", c.baseURL, path, c.apiKey)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return attemptResult{}, err
+	}
+	if cached != nil {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// Strip the URL, which contains the API key
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return attemptResult{}, err
+	}
+	defer resp.Body.Close()
+
+	result := attemptResult{statusCode: resp.StatusCode}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		result.notModified = true
+		return result, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		message := strings.TrimSpace(string(body))
+		if message == "" {
+			message = http.StatusText(resp.StatusCode)
+		}
+		result.retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+		return result, errors.New(message)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		// A connection dropped mid-body is as retryable as one that failed outright
+		result.statusCode = 0
+		return result, err
+	}
+
+	// Most SportsData.io feeds send no validators, so compare content as well
+	sum := sha256.Sum256(body)
+	hash := hex.EncodeToString(sum[:])
+	if cached != nil && cached.ContentHash == hash {
+		result.notModified = true
+		return result, nil
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return result, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	result.entry = &models.ResponseCache{
+		URL:          c.baseURL + path,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		ContentHash:  hash,
+	}
+	return result, nil
+}
+
+// classifyFailure maps a failed attempt to an error category and whether it is worth retrying
+func classifyFailure(statusCode int, err error) (error, bool) {
+	switch {
+	case statusCode == 0:
+		// Network error, no response
+		return ErrUpstream, true
+	case statusCode == http.StatusOK:
+		// The response could not be decoded; retrying would get the same payload
+		return ErrUpstream, false
+	case statusCode == http.StatusUnauthorized:
+		return ErrUnauthorized, false
+	case statusCode == http.StatusForbidden:
+		// SportsData.io answers 403 both for keys without access and for an exhausted call volume quota
+		if strings.Contains(strings.ToLower(err.Error()), "quota") {
+			return ErrQuotaExhausted, false
+		}
+		return ErrUnauthorized, false
+	case statusCode == http.StatusNotFound:
+		return ErrNotFound, false
+	case statusCode == http.StatusTooManyRequests:
+		return ErrQuotaExhausted, true
+	case statusCode >= 500:
+		return ErrUpstream, true
+	}
+	return ErrUpstream, false
+}
+
+// backoff returns the delay before the given retry: exponential from the base delay, capped
+// at the maximum, with the upper half randomized so that concurrent clients spread out
+func (c *Client) backoff(attempt int) time.Duration {
+	d := c.retryBaseDelay << (attempt - 1)
+	if d <= 0 || d > c.retryMaxDelay {
+		d = c.retryMaxDelay
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0)
+	}
+	return 0
+}
@@ -6,19 +6,51 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
 	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+	"github.com/web-dev-jesus/trendzone/internal/events"
 	"github.com/web-dev-jesus/trendzone/internal/logger"
 )
 
 type Service struct {
-	client        *Client
-	teamsRepo     *repositories.TeamsRepository
-	playersRepo   *repositories.PlayersRepository
-	standingsRepo *repositories.StandingsRepository
-	schedulesRepo *repositories.SchedulesRepository
-	gamesRepo     *repositories.GamesRepository
+	client               *Client
+	teamsRepo            *repositories.TeamsRepository
+	playersRepo          *repositories.PlayersRepository
+	standingsRepo        *repositories.StandingsRepository
+	schedulesRepo        *repositories.SchedulesRepository
+	gamesRepo            *repositories.GamesRepository
+	syncJobsRepo         *repositories.SyncJobsRepository
+	broker               *events.Broker
+	playerGameStatsRepo  *repositories.PlayerGameStatsRepository
+	teamGameStatsRepo    *repositories.TeamGameStatsRepository
+	teamSeasonStatsRepo  *repositories.TeamSeasonStatsRepository
+	injuriesRepo         *repositories.InjuriesRepository
+	depthChartsRepo      *repositories.DepthChartsRepository
+	playByPlayRepo       *repositories.PlayByPlayRepository
+	stadiumsRepo         *repositories.StadiumsRepository
+	oddsRepo             *repositories.OddsRepository
+	gamesSyncedHooks     []GamesSyncedHook
+	schedulesSyncedHooks []SchedulesSyncedHook
 }
 
+// Entity names reported in sync results
+const (
+	EntityStadiums        = "stadiums"
+	EntityTeams           = "teams"
+	EntityPlayers         = "players"
+	EntityStandings       = "standings"
+	EntitySchedules       = "schedules"
+	EntityGames           = "games"
+	EntityTeamSeasonStats = "team_season_stats"
+	EntityDepthCharts     = "depth_charts"
+
+	// Weekly entities are synced one week at a time and are not part of SyncAll
+	EntityPlayerGameStats = "player_game_stats"
+	EntityTeamGameStats   = "team_game_stats"
+	EntityInjuries        = "injuries"
+	EntityPlayByPlay      = "play_by_play"
+)
+
 func NewService(
 	client *Client,
 	teamsRepo *repositories.TeamsRepository,
@@ -26,203 +58,429 @@ func NewService(
 	standingsRepo *repositories.StandingsRepository,
 	schedulesRepo *repositories.SchedulesRepository,
 	gamesRepo *repositories.GamesRepository,
+	syncJobsRepo *repositories.SyncJobsRepository,
+	broker *events.Broker,
+	playerGameStatsRepo *repositories.PlayerGameStatsRepository,
+	teamGameStatsRepo *repositories.TeamGameStatsRepository,
+	teamSeasonStatsRepo *repositories.TeamSeasonStatsRepository,
+	injuriesRepo *repositories.InjuriesRepository,
+	depthChartsRepo *repositories.DepthChartsRepository,
+	playByPlayRepo *repositories.PlayByPlayRepository,
+	stadiumsRepo *repositories.StadiumsRepository,
+	oddsRepo *repositories.OddsRepository,
 ) *Service {
 	return &Service{
-		client:        client,
-		teamsRepo:     teamsRepo,
-		playersRepo:   playersRepo,
-		standingsRepo: standingsRepo,
-		schedulesRepo: schedulesRepo,
-		gamesRepo:     gamesRepo,
+		client:              client,
+		teamsRepo:           teamsRepo,
+		playersRepo:         playersRepo,
+		standingsRepo:       standingsRepo,
+		schedulesRepo:       schedulesRepo,
+		gamesRepo:           gamesRepo,
+		syncJobsRepo:        syncJobsRepo,
+		broker:              broker,
+		playerGameStatsRepo: playerGameStatsRepo,
+		teamGameStatsRepo:   teamGameStatsRepo,
+		teamSeasonStatsRepo: teamSeasonStatsRepo,
+		injuriesRepo:        injuriesRepo,
+		depthChartsRepo:     depthChartsRepo,
+		playByPlayRepo:      playByPlayRepo,
+		stadiumsRepo:        stadiumsRepo,
+		oddsRepo:            oddsRepo,
 	}
 }
 
+// ClientMetrics returns the SportsData.io request metrics per endpoint
+func (s *Service) ClientMetrics() map[string]EndpointMetrics {
+	return s.client.Metrics()
+}
+
 // SyncTeams fetches teams from SportsData.io API and stores them in the database
-func (s *Service) SyncTeams(ctx context.Context) error {
+func (s *Service) SyncTeams(ctx context.Context) (*models.SyncResult, error) {
 	log := logger.WithRequestContext(ctx).WithField("component", "sportsdata_service.SyncTeams")
 	log.Info("Syncing teams from SportsData.io API to database")
 
-	teams, err := s.client.GetTeams(ctx)
+	teams, fetch, err := s.client.GetTeams(ctx)
 	if err != nil {
 		log.WithError(err).Error("Failed to fetch teams from API")
-		return err
+		return nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Teams unchanged, skipped")
+		return skippedSyncResult(EntityTeams), nil
+	}
+
+	stored, err := s.teamsRepo.FindAll(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to load stored teams")
+		return nil, err
+	}
+	storedByID := make(map[int]*models.Team, len(stored))
+	for i := range stored {
+		storedByID[stored[i].TeamID] = &stored[i]
 	}
 
 	log.WithField("count", len(teams)).Info("Upserting teams in database")
 
-	successCount := 0
-	for _, team := range teams {
-		_, err := s.teamsRepo.UpsertByTeamID(ctx, &team)
-		if err != nil {
+	// Unchanged teams keep their LastUpdated so that their write is a no-op
+	for i := range teams {
+		if old := storedByID[teams[i].TeamID]; old != nil && unchanged(old, &teams[i]) {
+			teams[i].LastUpdated = old.LastUpdated
+		}
+	}
+
+	result, err := s.teamsRepo.BulkUpsertByTeamID(ctx, teams)
+	if err != nil {
+		log.WithError(err).Error("Failed to upsert teams")
+		return nil, err
+	}
+
+	for i := range teams {
+		if err, failed := result.Errors[i]; failed {
 			log.WithFields(logrus.Fields{
-				"team_id":  team.TeamID,
-				"team_key": team.Key,
+				"team_id":  teams[i].TeamID,
+				"team_key": teams[i].Key,
 				"error":    err.Error(),
 			}).Error("Failed to upsert team")
 			continue
 		}
-		successCount++
+		s.publishTeamUpdate(storedByID[teams[i].TeamID], &teams[i])
+	}
+
+	// Only remember the payload once all of it is stored, so a partial failure is retried next time
+	if result.Failed == 0 {
+		fetch.Commit(ctx)
 	}
 
+	syncResult := newSyncResult(EntityTeams, len(teams), result)
 	log.WithFields(logrus.Fields{
-		"success_count": successCount,
-		"total_count":   len(teams),
+		"success_count":   syncResult.SuccessCount,
+		"total_count":     syncResult.TotalCount,
+		"inserted_count":  syncResult.InsertedCount,
+		"modified_count":  syncResult.ModifiedCount,
+		"unchanged_count": syncResult.UnchangedCount,
 	}).Info("Teams sync completed")
 
-	return nil
+	return syncResult, nil
 }
 
 // SyncPlayers fetches players from SportsData.io API and stores them in the database
-func (s *Service) SyncPlayers(ctx context.Context) error {
+func (s *Service) SyncPlayers(ctx context.Context) (*models.SyncResult, error) {
 	log := logger.WithRequestContext(ctx).WithField("component", "sportsdata_service.SyncPlayers")
 	log.Info("Syncing players from SportsData.io API to database")
 
-	players, err := s.client.GetPlayers(ctx)
+	players, fetch, err := s.client.GetPlayers(ctx)
 	if err != nil {
 		log.WithError(err).Error("Failed to fetch players from API")
-		return err
+		return nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Players unchanged, skipped")
+		return skippedSyncResult(EntityPlayers), nil
+	}
+
+	stored, err := s.playersRepo.FindAll(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to load stored players")
+		return nil, err
+	}
+	storedByID := make(map[int]*models.Player, len(stored))
+	for i := range stored {
+		storedByID[stored[i].PlayerID] = &stored[i]
 	}
 
 	log.WithField("count", len(players)).Info("Upserting players in database")
 
-	successCount := 0
-	for _, player := range players {
-		_, err := s.playersRepo.UpsertByPlayerID(ctx, &player)
-		if err != nil {
+	// Unchanged players keep their LastUpdated so that their write is a no-op
+	for i := range players {
+		if old := storedByID[players[i].PlayerID]; old != nil && unchanged(old, &players[i]) {
+			players[i].LastUpdated = old.LastUpdated
+		}
+	}
+
+	result, err := s.playersRepo.BulkUpsertByPlayerID(ctx, players)
+	if err != nil {
+		log.WithError(err).Error("Failed to upsert players")
+		return nil, err
+	}
+
+	for i := range players {
+		if err, failed := result.Errors[i]; failed {
 			log.WithFields(logrus.Fields{
-				"player_id":   player.PlayerID,
-				"player_name": player.Name,
+				"player_id":   players[i].PlayerID,
+				"player_name": players[i].Name,
 				"error":       err.Error(),
 			}).Error("Failed to upsert player")
 			continue
 		}
-		successCount++
+		s.publishPlayerUpdate(storedByID[players[i].PlayerID], &players[i])
 	}
 
+	// Only remember the payload once all of it is stored, so a partial failure is retried next time
+	if result.Failed == 0 {
+		fetch.Commit(ctx)
+	}
+
+	syncResult := newSyncResult(EntityPlayers, len(players), result)
 	log.WithFields(logrus.Fields{
-		"success_count": successCount,
-		"total_count":   len(players),
+		"success_count":   syncResult.SuccessCount,
+		"total_count":     syncResult.TotalCount,
+		"inserted_count":  syncResult.InsertedCount,
+		"modified_count":  syncResult.ModifiedCount,
+		"unchanged_count": syncResult.UnchangedCount,
 	}).Info("Players sync completed")
 
-	return nil
+	return syncResult, nil
 }
 
 // SyncStandings fetches standings from SportsData.io API and stores them in the database
-func (s *Service) SyncStandings(ctx context.Context, season string) error {
+func (s *Service) SyncStandings(ctx context.Context, season string) (*models.SyncResult, error) {
 	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
 		"component": "sportsdata_service.SyncStandings",
 		"season":    season,
 	})
 	log.Info("Syncing standings from SportsData.io API to database")
 
-	standings, err := s.client.GetStandings(ctx, season)
+	standings, fetch, err := s.client.GetStandings(ctx, season)
 	if err != nil {
 		log.WithError(err).Error("Failed to fetch standings from API")
-		return err
+		return nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Standings unchanged, skipped")
+		return skippedSyncResult(EntityStandings), nil
+	}
+
+	stored, err := s.standingsRepo.FindAll(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to load stored standings")
+		return nil, err
+	}
+	storedByTeam := make(map[string]*models.Standing, len(stored))
+	for i := range stored {
+		storedByTeam[standingKey(&stored[i])] = &stored[i]
 	}
 
 	log.WithField("count", len(standings)).Info("Upserting standings in database")
 
-	successCount := 0
-	for _, standing := range standings {
-		_, err := s.standingsRepo.UpsertByTeamAndSeason(ctx, &standing)
-		if err != nil {
+	// Unchanged standings keep their LastUpdated so that their write is a no-op
+	for i := range standings {
+		if old := storedByTeam[standingKey(&standings[i])]; old != nil && unchanged(old, &standings[i]) {
+			standings[i].LastUpdated = old.LastUpdated
+		}
+	}
+
+	result, err := s.standingsRepo.BulkUpsertByTeamAndSeason(ctx, standings)
+	if err != nil {
+		log.WithError(err).Error("Failed to upsert standings")
+		return nil, err
+	}
+
+	for i := range standings {
+		if err, failed := result.Errors[i]; failed {
 			log.WithFields(logrus.Fields{
-				"team":  standing.Team,
-				"name":  standing.Name,
+				"team":  standings[i].Team,
+				"name":  standings[i].Name,
 				"error": err.Error(),
 			}).Error("Failed to upsert standing")
 			continue
 		}
-		successCount++
+		s.publishStandingUpdate(storedByTeam[standingKey(&standings[i])], &standings[i])
+	}
+
+	// Only remember the payload once all of it is stored, so a partial failure is retried next time
+	if result.Failed == 0 {
+		fetch.Commit(ctx)
 	}
 
+	syncResult := newSyncResult(EntityStandings, len(standings), result)
 	log.WithFields(logrus.Fields{
-		"success_count": successCount,
-		"total_count":   len(standings),
+		"success_count":   syncResult.SuccessCount,
+		"total_count":     syncResult.TotalCount,
+		"inserted_count":  syncResult.InsertedCount,
+		"modified_count":  syncResult.ModifiedCount,
+		"unchanged_count": syncResult.UnchangedCount,
 	}).Info("Standings sync completed")
 
-	return nil
+	return syncResult, nil
 }
 
 // SyncSchedules fetches schedules from SportsData.io API and stores them in the database
-func (s *Service) SyncSchedules(ctx context.Context, season string) error {
+func (s *Service) SyncSchedules(ctx context.Context, season string) (*models.SyncResult, error) {
 	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
 		"component": "sportsdata_service.SyncSchedules",
 		"season":    season,
 	})
 	log.Info("Syncing schedules from SportsData.io API to database")
 
-	schedules, err := s.client.GetSchedules(ctx, season)
+	schedules, fetch, err := s.client.GetSchedules(ctx, season)
 	if err != nil {
 		log.WithError(err).Error("Failed to fetch schedules from API")
-		return err
+		return nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Schedules unchanged, skipped")
+		return skippedSyncResult(EntitySchedules), nil
+	}
+
+	stored, err := s.storedSchedulesByKey(ctx, schedules)
+	if err != nil {
+		log.WithError(err).Error("Failed to load stored schedules")
+		return nil, err
 	}
 
 	log.WithField("count", len(schedules)).Info("Upserting schedules in database")
 
-	successCount := 0
-	for _, schedule := range schedules {
-		_, err := s.schedulesRepo.UpsertByGameKey(ctx, &schedule)
-		if err != nil {
+	// Unchanged schedules keep their LastUpdated so that their write is a no-op
+	for i := range schedules {
+		if old := stored[schedules[i].GameKey]; old != nil && unchanged(old, &schedules[i]) {
+			schedules[i].LastUpdated = old.LastUpdated
+		}
+	}
+
+	result, err := s.schedulesRepo.BulkUpsertByGameKey(ctx, schedules)
+	if err != nil {
+		log.WithError(err).Error("Failed to upsert schedules")
+		return nil, err
+	}
+
+	lines := []models.OddsSnapshot{}
+	changed := []models.Schedule{}
+	for i := range schedules {
+		if err, failed := result.Errors[i]; failed {
 			log.WithFields(logrus.Fields{
-				"game_key": schedule.GameKey,
-				"teams":    schedule.AwayTeam + "@" + schedule.HomeTeam,
+				"game_key": schedules[i].GameKey,
+				"teams":    schedules[i].AwayTeam + "@" + schedules[i].HomeTeam,
 				"error":    err.Error(),
 			}).Error("Failed to upsert schedule")
 			continue
 		}
-		successCount++
+		if old := stored[schedules[i].GameKey]; old == nil || !unchanged(old, &schedules[i]) {
+			changed = append(changed, schedules[i])
+		}
+		if line := scheduleOdds(&schedules[i]); line != nil {
+			lines = append(lines, *line)
+		}
+	}
+
+	// The schedules are stored by now, so a failed snapshot only loses a point of line history
+	snapshots, err := s.recordOddsSnapshots(ctx, lines)
+	if err != nil {
+		log.WithError(err).Error("Failed to record odds snapshots")
+	}
+
+	s.schedulesSynced(ctx, changed)
+
+	// Only remember the payload once all of it is stored, so a partial failure is retried next time
+	if result.Failed == 0 {
+		fetch.Commit(ctx)
 	}
 
+	syncResult := newSyncResult(EntitySchedules, len(schedules), result)
 	log.WithFields(logrus.Fields{
-		"success_count": successCount,
-		"total_count":   len(schedules),
+		"success_count":   syncResult.SuccessCount,
+		"total_count":     syncResult.TotalCount,
+		"inserted_count":  syncResult.InsertedCount,
+		"modified_count":  syncResult.ModifiedCount,
+		"unchanged_count": syncResult.UnchangedCount,
+		"odds_snapshots":  snapshots,
 	}).Info("Schedules sync completed")
 
-	return nil
+	return syncResult, nil
 }
 
 // SyncGames fetches games from SportsData.io API and stores them in the database
-func (s *Service) SyncGames(ctx context.Context, season string) error {
+func (s *Service) SyncGames(ctx context.Context, season string) (*models.SyncResult, error) {
 	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
 		"component": "sportsdata_service.SyncGames",
 		"season":    season,
 	})
 	log.Info("Syncing games from SportsData.io API to database")
 
-	games, err := s.client.GetGames(ctx, season)
+	games, fetch, err := s.client.GetGames(ctx, season)
 	if err != nil {
 		log.WithError(err).Error("Failed to fetch games from API")
-		return err
+		return nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Games unchanged, skipped")
+		return skippedSyncResult(EntityGames), nil
+	}
+
+	// Load the stored versions so that only real changes are published
+	stored, err := s.storedGamesByKey(ctx, games)
+	if err != nil {
+		log.WithError(err).Error("Failed to load stored games")
+		return nil, err
 	}
 
 	log.WithField("count", len(games)).Info("Upserting games in database")
 
-	successCount := 0
-	for _, game := range games {
-		_, err := s.gamesRepo.UpsertByGameKey(ctx, &game)
-		if err != nil {
+	// Unchanged games keep their LastUpdated so that their write is a no-op
+	for i := range games {
+		if old := stored[games[i].GameKey]; old != nil && unchanged(old, &games[i]) {
+			games[i].LastUpdated = old.LastUpdated
+		}
+	}
+
+	result, err := s.gamesRepo.BulkUpsertByGameKey(ctx, games)
+	if err != nil {
+		log.WithError(err).Error("Failed to upsert games")
+		return nil, err
+	}
+
+	lines := []models.OddsSnapshot{}
+	changed := []models.Game{}
+	for i := range games {
+		if err, failed := result.Errors[i]; failed {
 			log.WithFields(logrus.Fields{
-				"game_key": game.GameKey,
-				"teams":    game.AwayTeam + "@" + game.HomeTeam,
+				"game_key": games[i].GameKey,
+				"teams":    games[i].AwayTeam + "@" + games[i].HomeTeam,
 				"error":    err.Error(),
 			}).Error("Failed to upsert game")
 			continue
 		}
-		successCount++
+		old := stored[games[i].GameKey]
+		if old == nil || gameChanged(old, &games[i]) {
+			s.publishGameUpdate(old, &games[i])
+		}
+		if old == nil || !unchanged(old, &games[i]) {
+			changed = append(changed, games[i])
+		}
+		if line := gameOdds(&games[i]); line != nil {
+			lines = append(lines, *line)
+		}
+	}
+
+	// The games are stored by now, so a failed snapshot only loses a point of line history
+	snapshots, err := s.recordOddsSnapshots(ctx, lines)
+	if err != nil {
+		log.WithError(err).Error("Failed to record odds snapshots")
+	}
+
+	s.gamesSynced(ctx, changed)
+
+	// Only remember the payload once all of it is stored, so a partial failure is retried next time
+	if result.Failed == 0 {
+		fetch.Commit(ctx)
 	}
 
+	syncResult := newSyncResult(EntityGames, len(games), result)
 	log.WithFields(logrus.Fields{
-		"success_count": successCount,
-		"total_count":   len(games),
+		"success_count":   syncResult.SuccessCount,
+		"total_count":     syncResult.TotalCount,
+		"inserted_count":  syncResult.InsertedCount,
+		"modified_count":  syncResult.ModifiedCount,
+		"unchanged_count": syncResult.UnchangedCount,
+		"odds_snapshots":  snapshots,
 	}).Info("Games sync completed")
 
-	return nil
+	return syncResult, nil
 }
 
-// SyncAll syncs all data for a specified season
-func (s *Service) SyncAll(ctx context.Context, season string) error {
+// SyncAll syncs all data for a specified season and returns the result of each entity sync. It stops at
+// the first entity that fails, whose result carries the error, except for the supplementary entities:
+// stadiums, depth charts and team season stats.
+func (s *Service) SyncAll(ctx context.Context, season string) ([]models.SyncResult, error) {
 	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
 		"component": "sportsdata_service.SyncAll",
 		"season":    season,
@@ -230,39 +488,98 @@ func (s *Service) SyncAll(ctx context.Context, season string) error {
 	log.Info("Starting complete data sync")
 
 	startTime := time.Now()
+	results := []models.SyncResult{}
+
+	// Sync stadiums
+	result, err := s.SyncStadiums(ctx)
+	if err != nil {
+		// Stadiums supplement the core entities, so a failure is reported without failing the sync
+		log.WithError(err).Error("Failed to sync stadiums")
+		results = append(results, *failedSyncResult(EntityStadiums, err))
+	} else {
+		results = append(results, *result)
+	}
 
 	// Sync teams
-	if err := s.SyncTeams(ctx); err != nil {
+	result, err = s.SyncTeams(ctx)
+	if err != nil {
 		log.WithError(err).Error("Failed to sync teams")
-		return err
+		results = append(results, *failedSyncResult(EntityTeams, err))
+		return results, err
 	}
+	results = append(results, *result)
 
 	// Sync players
-	if err := s.SyncPlayers(ctx); err != nil {
+	result, err = s.SyncPlayers(ctx)
+	if err != nil {
 		log.WithError(err).Error("Failed to sync players")
-		return err
+		results = append(results, *failedSyncResult(EntityPlayers, err))
+		return results, err
+	}
+	results = append(results, *result)
+
+	// Sync depth charts
+	result, err = s.SyncDepthCharts(ctx)
+	if err != nil {
+		// Depth charts supplement the core entities, so a failure is reported without failing the sync
+		log.WithError(err).Error("Failed to sync depth charts")
+		results = append(results, *failedSyncResult(EntityDepthCharts, err))
+	} else {
+		results = append(results, *result)
 	}
 
 	// Sync standings
-	if err := s.SyncStandings(ctx, season); err != nil {
+	result, err = s.SyncStandings(ctx, season)
+	if err != nil {
 		log.WithError(err).Error("Failed to sync standings")
-		return err
+		results = append(results, *failedSyncResult(EntityStandings, err))
+		return results, err
 	}
+	results = append(results, *result)
 
 	// Sync schedules
-	if err := s.SyncSchedules(ctx, season); err != nil {
+	result, err = s.SyncSchedules(ctx, season)
+	if err != nil {
 		log.WithError(err).Error("Failed to sync schedules")
-		return err
+		results = append(results, *failedSyncResult(EntitySchedules, err))
+		return results, err
 	}
+	results = append(results, *result)
 
 	// Sync games
-	if err := s.SyncGames(ctx, season); err != nil {
+	result, err = s.SyncGames(ctx, season)
+	if err != nil {
 		log.WithError(err).Error("Failed to sync games")
-		return err
+		results = append(results, *failedSyncResult(EntityGames, err))
+		return results, err
+	}
+	results = append(results, *result)
+
+	// Sync team season stats
+	result, err = s.SyncTeamSeasonStats(ctx, season)
+	if err != nil {
+		// Team season stats supplement the core entities, so a failure is reported without failing the sync
+		log.WithError(err).Error("Failed to sync team season stats")
+		results = append(results, *failedSyncResult(EntityTeamSeasonStats, err))
+	} else {
+		results = append(results, *result)
 	}
 
 	duration := time.Since(startTime)
+	failed := 0
+	for _, result := range results {
+		if result.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		log.WithFields(logrus.Fields{
+			"duration_ms":     duration.Milliseconds(),
+			"failed_entities": failed,
+		}).Warn("Data synced with failures")
+		return results, nil
+	}
 	log.WithField("duration_ms", duration.Milliseconds()).Info("All data synced successfully")
 
-	return nil
+	return results, nil
 }
@@ -0,0 +1,75 @@
+package sportsdata
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// SyncStadiums fetches stadiums from SportsData.io API and stores them in the database
+func (s *Service) SyncStadiums(ctx context.Context) (*models.SyncResult, error) {
+	log := logger.WithRequestContext(ctx).WithField("component", "sportsdata_service.SyncStadiums")
+	log.Info("Syncing stadiums from SportsData.io API to database")
+
+	stadiums, fetch, err := s.client.GetStadiums(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch stadiums from API")
+		return nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Stadiums unchanged, skipped")
+		return skippedSyncResult(EntityStadiums), nil
+	}
+
+	stored, err := s.stadiumsRepo.FindAll(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to load stored stadiums")
+		return nil, err
+	}
+	storedByID := make(map[int]*models.Stadium, len(stored))
+	for i := range stored {
+		storedByID[stored[i].StadiumID] = &stored[i]
+	}
+
+	log.WithField("count", len(stadiums)).Info("Upserting stadiums in database")
+
+	// Unchanged stadiums keep their LastUpdated so that their write is a no-op
+	for i := range stadiums {
+		if old := storedByID[stadiums[i].StadiumID]; old != nil && unchanged(old, &stadiums[i]) {
+			stadiums[i].LastUpdated = old.LastUpdated
+		}
+	}
+
+	result, err := s.stadiumsRepo.BulkUpsertByStadiumID(ctx, stadiums)
+	if err != nil {
+		log.WithError(err).Error("Failed to upsert stadiums")
+		return nil, err
+	}
+
+	for i, err := range result.Errors {
+		log.WithFields(logrus.Fields{
+			"stadium_id": stadiums[i].StadiumID,
+			"name":       stadiums[i].Name,
+			"error":      err.Error(),
+		}).Error("Failed to upsert stadium")
+	}
+
+	// Only remember the payload once all of it is stored, so a partial failure is retried next time
+	if result.Failed == 0 {
+		fetch.Commit(ctx)
+	}
+
+	syncResult := newSyncResult(EntityStadiums, len(stadiums), result)
+	log.WithFields(logrus.Fields{
+		"success_count":   syncResult.SuccessCount,
+		"total_count":     syncResult.TotalCount,
+		"inserted_count":  syncResult.InsertedCount,
+		"modified_count":  syncResult.ModifiedCount,
+		"unchanged_count": syncResult.UnchangedCount,
+	}).Info("Stadiums sync completed")
+
+	return syncResult, nil
+}
@@ -0,0 +1,195 @@
+package sportsdata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/logger"
+)
+
+// SyncTeamGameStats fetches the team statistics of a week from SportsData.io API and stores them in the database
+func (s *Service) SyncTeamGameStats(ctx context.Context, season string, week int) (*models.SyncResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_service.SyncTeamGameStats",
+		"season":    season,
+		"week":      week,
+	})
+	log.Info("Syncing team game stats from SportsData.io API to database")
+
+	stats, fetch, err := s.client.GetTeamGameStatsByWeek(ctx, season, week)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch team game stats from API")
+		return nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Team game stats unchanged, skipped")
+		return skippedSyncResult(EntityTeamGameStats), nil
+	}
+
+	stored, err := s.storedTeamGameStats(ctx, stats)
+	if err != nil {
+		log.WithError(err).Error("Failed to load stored team game stats")
+		return nil, err
+	}
+
+	log.WithField("count", len(stats)).Info("Upserting team game stats in database")
+
+	// Unchanged stats keep their LastUpdated so that their write is a no-op
+	for i := range stats {
+		if old := stored[teamGameKey(&stats[i])]; old != nil && unchanged(old, &stats[i]) {
+			stats[i].LastUpdated = old.LastUpdated
+		}
+	}
+
+	result, err := s.teamGameStatsRepo.BulkUpsertByTeamAndGame(ctx, stats)
+	if err != nil {
+		log.WithError(err).Error("Failed to upsert team game stats")
+		return nil, err
+	}
+
+	for i, err := range result.Errors {
+		log.WithFields(logrus.Fields{
+			"team":     stats[i].Team,
+			"game_key": stats[i].GameKey,
+			"error":    err.Error(),
+		}).Error("Failed to upsert team game stats")
+	}
+
+	// Only remember the payload once all of it is stored, so a partial failure is retried next time
+	if result.Failed == 0 {
+		fetch.Commit(ctx)
+	}
+
+	syncResult := newSyncResult(EntityTeamGameStats, len(stats), result)
+	log.WithFields(logrus.Fields{
+		"success_count":   syncResult.SuccessCount,
+		"total_count":     syncResult.TotalCount,
+		"inserted_count":  syncResult.InsertedCount,
+		"modified_count":  syncResult.ModifiedCount,
+		"unchanged_count": syncResult.UnchangedCount,
+	}).Info("Team game stats sync completed")
+
+	return syncResult, nil
+}
+
+// SyncTeamSeasonStats fetches the season statistics of every team from SportsData.io API and stores them in the database
+func (s *Service) SyncTeamSeasonStats(ctx context.Context, season string) (*models.SyncResult, error) {
+	log := logger.WithRequestContext(ctx).WithFields(logrus.Fields{
+		"component": "sportsdata_service.SyncTeamSeasonStats",
+		"season":    season,
+	})
+	log.Info("Syncing team season stats from SportsData.io API to database")
+
+	stats, fetch, err := s.client.GetTeamSeasonStats(ctx, season)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch team season stats from API")
+		return nil, err
+	}
+	if fetch.NotModified {
+		log.Info("Team season stats unchanged, skipped")
+		return skippedSyncResult(EntityTeamSeasonStats), nil
+	}
+
+	stored, err := s.storedTeamSeasonStats(ctx, stats)
+	if err != nil {
+		log.WithError(err).Error("Failed to load stored team season stats")
+		return nil, err
+	}
+
+	log.WithField("count", len(stats)).Info("Upserting team season stats in database")
+
+	// Unchanged stats keep their LastUpdated so that their write is a no-op
+	for i := range stats {
+		if old := stored[teamSeasonKey(&stats[i])]; old != nil && unchanged(old, &stats[i]) {
+			stats[i].LastUpdated = old.LastUpdated
+		}
+	}
+
+	result, err := s.teamSeasonStatsRepo.BulkUpsertByTeamAndSeason(ctx, stats)
+	if err != nil {
+		log.WithError(err).Error("Failed to upsert team season stats")
+		return nil, err
+	}
+
+	for i, err := range result.Errors {
+		log.WithFields(logrus.Fields{
+			"team":        stats[i].Team,
+			"season":      stats[i].Season,
+			"season_type": stats[i].SeasonType,
+			"error":       err.Error(),
+		}).Error("Failed to upsert team season stats")
+	}
+
+	// Only remember the payload once all of it is stored, so a partial failure is retried next time
+	if result.Failed == 0 {
+		fetch.Commit(ctx)
+	}
+
+	syncResult := newSyncResult(EntityTeamSeasonStats, len(stats), result)
+	log.WithFields(logrus.Fields{
+		"success_count":   syncResult.SuccessCount,
+		"total_count":     syncResult.TotalCount,
+		"inserted_count":  syncResult.InsertedCount,
+		"modified_count":  syncResult.ModifiedCount,
+		"unchanged_count": syncResult.UnchangedCount,
+	}).Info("Team season stats sync completed")
+
+	return syncResult, nil
+}
+
+// storedTeamGameStats loads the stored versions of the given stats keyed by teamGameKey
+func (s *Service) storedTeamGameStats(ctx context.Context, stats []models.TeamGameStats) (map[string]*models.TeamGameStats, error) {
+	seen := map[string]bool{}
+	gameKeys := []string{}
+	for _, stat := range stats {
+		if !seen[stat.GameKey] {
+			seen[stat.GameKey] = true
+			gameKeys = append(gameKeys, stat.GameKey)
+		}
+	}
+
+	stored, err := s.teamGameStatsRepo.FindByGameKeys(ctx, gameKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	storedByKey := make(map[string]*models.TeamGameStats, len(stored))
+	for i := range stored {
+		storedByKey[teamGameKey(&stored[i])] = &stored[i]
+	}
+	return storedByKey, nil
+}
+
+// storedTeamSeasonStats loads the stored versions of the given stats keyed by teamSeasonKey
+func (s *Service) storedTeamSeasonStats(ctx context.Context, stats []models.TeamSeasonStats) (map[string]*models.TeamSeasonStats, error) {
+	storedByKey := map[string]*models.TeamSeasonStats{}
+	seen := map[int]bool{}
+	for _, stat := range stats {
+		if seen[stat.Season] {
+			continue
+		}
+		seen[stat.Season] = true
+
+		stored, err := s.teamSeasonStatsRepo.FindBySeason(ctx, stat.Season)
+		if err != nil {
+			return nil, err
+		}
+		for i := range stored {
+			storedByKey[teamSeasonKey(&stored[i])] = &stored[i]
+		}
+	}
+	return storedByKey, nil
+}
+
+// teamGameKey identifies a team's stats in one game
+func teamGameKey(stats *models.TeamGameStats) string {
+	return stats.Team + "/" + stats.GameKey
+}
+
+// teamSeasonKey identifies a team's stats for one season and season type
+func teamSeasonKey(stats *models.TeamSeasonStats) string {
+	return fmt.Sprintf("%s/%d/%d", stats.Team, stats.Season, stats.SeasonType)
+}
@@ -0,0 +1,88 @@
+package sportsdata
+
+import (
+	"context"
+	"reflect"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+	"github.com/web-dev-jesus/trendzone/internal/db/mongodb/repositories"
+	"github.com/web-dev-jesus/trendzone/internal/events"
+)
+
+// unchanged reports whether a fetched document has the same content as its stored version.
+// Both are compared in their stored form, so fields that aren't stored are ignored and times
+// are compared in UTC at MongoDB's millisecond precision.
+func unchanged(old interface{}, document interface{}) bool {
+	diff, err := events.Diff(stored(old), stored(document))
+	return err == nil && len(diff) == 0
+}
+
+// stored round-trips a document pointer through BSON to the form it has once read back from
+// MongoDB. The document is returned as is when it can't be converted.
+func stored(document interface{}) interface{} {
+	t := reflect.TypeOf(document)
+	if t == nil || t.Kind() != reflect.Ptr {
+		return document
+	}
+
+	data, err := bson.Marshal(document)
+	if err != nil {
+		return document
+	}
+
+	decoded := reflect.New(t.Elem()).Interface()
+	if err := bson.Unmarshal(data, decoded); err != nil {
+		return document
+	}
+	return decoded
+}
+
+// newSyncResult summarizes a bulk upsert of total documents
+func newSyncResult(entity string, total int, result *repositories.BulkResult) *models.SyncResult {
+	return &models.SyncResult{
+		Entity:         entity,
+		SuccessCount:   total - result.Failed,
+		FailureCount:   result.Failed,
+		TotalCount:     total,
+		InsertedCount:  result.Inserted,
+		ModifiedCount:  result.Modified,
+		UnchangedCount: result.Unchanged,
+	}
+}
+
+// skippedSyncResult reports a sync whose SportsData.io payload was unchanged since the last sync
+func skippedSyncResult(entity string) *models.SyncResult {
+	return &models.SyncResult{
+		Entity:  entity,
+		Skipped: true,
+	}
+}
+
+// failedSyncResult reports an entity whose sync failed
+func failedSyncResult(entity string, err error) *models.SyncResult {
+	return &models.SyncResult{
+		Entity: entity,
+		Error:  err.Error(),
+	}
+}
+
+// storedSchedulesByKey loads the stored versions of the given schedules keyed by GameKey
+func (s *Service) storedSchedulesByKey(ctx context.Context, schedules []models.Schedule) (map[string]*models.Schedule, error) {
+	gameKeys := make([]string, 0, len(schedules))
+	for _, schedule := range schedules {
+		gameKeys = append(gameKeys, schedule.GameKey)
+	}
+
+	stored, err := s.schedulesRepo.FindByGameKeys(ctx, gameKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	storedByKey := make(map[string]*models.Schedule, len(stored))
+	for i := range stored {
+		storedByKey[stored[i].GameKey] = &stored[i]
+	}
+	return storedByKey, nil
+}
@@ -0,0 +1,305 @@
+// Package standings computes NFL standings and playoff seeding from game results, applying the league's
+// tiebreaking procedures
+package standings
+
+import (
+	"math"
+	"sort"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+)
+
+// TeamStanding is a team's record and place in its division and conference
+type TeamStanding struct {
+	Team       string `json:"team"`
+	Name       string `json:"name"`
+	Conference string `json:"conference"`
+	Division   string `json:"division"`
+
+	Wins          int     `json:"wins"`
+	Losses        int     `json:"losses"`
+	Ties          int     `json:"ties"`
+	Percentage    float64 `json:"percentage"`
+	PointsFor     int     `json:"pointsFor"`
+	PointsAgainst int     `json:"pointsAgainst"`
+	NetPoints     int     `json:"netPoints"`
+
+	DivisionWins     int `json:"divisionWins"`
+	DivisionLosses   int `json:"divisionLosses"`
+	DivisionTies     int `json:"divisionTies"`
+	ConferenceWins   int `json:"conferenceWins"`
+	ConferenceLosses int `json:"conferenceLosses"`
+	ConferenceTies   int `json:"conferenceTies"`
+	HomeWins         int `json:"homeWins"`
+	HomeLosses       int `json:"homeLosses"`
+	HomeTies         int `json:"homeTies"`
+	AwayWins         int `json:"awayWins"`
+	AwayLosses       int `json:"awayLosses"`
+	AwayTies         int `json:"awayTies"`
+
+	// StrengthOfVictory is the combined percentage of the opponents the team beat,
+	// StrengthOfSchedule that of every opponent it played
+	StrengthOfVictory  float64 `json:"strengthOfVictory"`
+	StrengthOfSchedule float64 `json:"strengthOfSchedule"`
+
+	DivisionRank   int `json:"divisionRank"`
+	ConferenceRank int `json:"conferenceRank"`
+	// Seed is the team's playoff seed if the season ended now, 0 if it would miss the playoffs
+	Seed           int  `json:"seed"`
+	DivisionWinner bool `json:"divisionWinner"`
+	Bye            bool `json:"bye"`
+
+	// DivisionTiebreaker and ConferenceTiebreaker name the tiebreaker step that ranked the team above the
+	// teams it was tied with, empty when it wasn't tied
+	DivisionTiebreaker   string `json:"divisionTiebreaker,omitempty"`
+	ConferenceTiebreaker string `json:"conferenceTiebreaker,omitempty"`
+}
+
+// Standings are the computed standings of a season, ordered by conference and conference rank
+type Standings struct {
+	Season      int            `json:"season"`
+	ThroughWeek int            `json:"throughWeek"`
+	Teams       []TeamStanding `json:"teams"`
+}
+
+// Format returns how many teams of each conference make the playoffs in a season and how many of them
+// get a first-round bye
+func Format(season int) (playoffTeams int, byes int) {
+	if season < 2020 {
+		return 6, 2
+	}
+	return 7, 1
+}
+
+// Compute computes the standings of a season from its regular season games. Only final games count;
+// games of teams that are not in teams are ignored. ThroughWeek is the last week with a counted game.
+func Compute(season int, teams []models.Team, games []models.Game) *Standings {
+	tb := newTable(teams)
+
+	throughWeek := 0
+	for i := range games {
+		if tb.addGame(&games[i]) && games[i].Week > throughWeek {
+			throughWeek = games[i].Week
+		}
+	}
+
+	standings := &Standings{
+		Season:      season,
+		ThroughWeek: throughWeek,
+		Teams:       make([]TeamStanding, 0, len(tb.keys)),
+	}
+	for _, standing := range tb.rank(season) {
+		standings.Teams = append(standings.Teams, *standing)
+	}
+	return standings
+}
+
+// record is a won-lost-tied record
+type record struct {
+	wins   int
+	losses int
+	ties   int
+}
+
+func (r *record) add(pointsFor int, pointsAgainst int) {
+	switch {
+	case pointsFor > pointsAgainst:
+		r.wins++
+	case pointsFor < pointsAgainst:
+		r.losses++
+	default:
+		r.ties++
+	}
+}
+
+func (r record) games() int {
+	return r.wins + r.losses + r.ties
+}
+
+// pct is the won-lost-tied percentage, counting a tie as half a win
+func (r record) pct() float64 {
+	if r.games() == 0 {
+		return 0
+	}
+	return (float64(r.wins) + float64(r.ties)/2) / float64(r.games())
+}
+
+// result is one game from a team's side
+type result struct {
+	opponent      string
+	pointsFor     int
+	pointsAgainst int
+}
+
+type team struct {
+	key        string
+	name       string
+	conference string
+	division   string
+
+	results       []result
+	overall       record
+	divisional    record
+	conferential  record
+	home          record
+	away          record
+	pointsFor     int
+	pointsAgainst int
+
+	standing *TeamStanding
+}
+
+// table holds the teams of a season while their standings are computed
+type table struct {
+	teams map[string]*team
+	keys  []string
+
+	// conferencePointsRank and leaguePointsRank are the combined points scored and allowed rankings,
+	// computed on first use
+	conferencePointsRank map[string]int
+	leaguePointsRank     map[string]int
+}
+
+func newTable(teams []models.Team) *table {
+	tb := &table{teams: make(map[string]*team, len(teams))}
+	for _, t := range teams {
+		tb.teams[t.Key] = &team{
+			key:        t.Key,
+			name:       t.FullName,
+			conference: t.Conference,
+			division:   t.Division,
+		}
+		tb.keys = append(tb.keys, t.Key)
+	}
+	sort.Strings(tb.keys)
+	return tb
+}
+
+// addGame counts a final game and reports whether it was counted
+func (tb *table) addGame(game *models.Game) bool {
+	if game.Status != models.GameStatusFinal && game.Status != models.GameStatusFinalOT {
+		return false
+	}
+	home, away := tb.teams[game.HomeTeam], tb.teams[game.AwayTeam]
+	if home == nil || away == nil {
+		return false
+	}
+
+	home.addResult(away, game.HomeScore, game.AwayScore, true)
+	away.addResult(home, game.AwayScore, game.HomeScore, false)
+	return true
+}
+
+func (t *team) addResult(opponent *team, pointsFor int, pointsAgainst int, home bool) {
+	t.results = append(t.results, result{opponent: opponent.key, pointsFor: pointsFor, pointsAgainst: pointsAgainst})
+	t.overall.add(pointsFor, pointsAgainst)
+	if home {
+		t.home.add(pointsFor, pointsAgainst)
+	} else {
+		t.away.add(pointsFor, pointsAgainst)
+	}
+	if t.conference == opponent.conference {
+		t.conferential.add(pointsFor, pointsAgainst)
+		if t.division == opponent.division {
+			t.divisional.add(pointsFor, pointsAgainst)
+		}
+	}
+	t.pointsFor += pointsFor
+	t.pointsAgainst += pointsAgainst
+}
+
+// rank ranks every division and conference and seeds the playoff teams
+func (tb *table) rank(season int) []*TeamStanding {
+	playoffTeams, byes := Format(season)
+
+	conferences := map[string][]string{}
+	divisions := map[string][]string{}
+	for _, key := range tb.keys {
+		t := tb.teams[key]
+		t.standing = tb.standing(t)
+		conferences[t.conference] = append(conferences[t.conference], key)
+		divisions[t.conference+" "+t.division] = append(divisions[t.conference+" "+t.division], key)
+	}
+
+	for _, keys := range divisions {
+		order, steps := tb.order(keys, tb.divisionTie)
+		for i, key := range order {
+			standing := tb.teams[key].standing
+			standing.DivisionRank = i + 1
+			standing.DivisionWinner = i == 0
+			standing.DivisionTiebreaker = steps[key]
+		}
+	}
+
+	names := make([]string, 0, len(conferences))
+	for name := range conferences {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	standings := make([]*TeamStanding, 0, len(tb.keys))
+	for _, name := range names {
+		var winners, others []string
+		for _, key := range conferences[name] {
+			if tb.teams[key].standing.DivisionWinner {
+				winners = append(winners, key)
+			} else {
+				others = append(others, key)
+			}
+		}
+
+		// Division winners are seeded first, then the other teams compete for the wild cards
+		winnerOrder, winnerSteps := tb.order(winners, tb.wildCardTie)
+		otherOrder, otherSteps := tb.order(others, tb.wildCardTie)
+		for i, key := range append(winnerOrder, otherOrder...) {
+			standing := tb.teams[key].standing
+			standing.ConferenceRank = i + 1
+			if i < playoffTeams {
+				standing.Seed = i + 1
+				standing.Bye = i < byes
+			}
+			if step, ok := winnerSteps[key]; ok {
+				standing.ConferenceTiebreaker = step
+			} else {
+				standing.ConferenceTiebreaker = otherSteps[key]
+			}
+			standings = append(standings, standing)
+		}
+	}
+	return standings
+}
+
+// standing builds a team's standing from its results
+func (tb *table) standing(t *team) *TeamStanding {
+	return &TeamStanding{
+		Team:               t.key,
+		Name:               t.name,
+		Conference:         t.conference,
+		Division:           t.division,
+		Wins:               t.overall.wins,
+		Losses:             t.overall.losses,
+		Ties:               t.overall.ties,
+		Percentage:         round(t.overall.pct()),
+		PointsFor:          t.pointsFor,
+		PointsAgainst:      t.pointsAgainst,
+		NetPoints:          t.pointsFor - t.pointsAgainst,
+		DivisionWins:       t.divisional.wins,
+		DivisionLosses:     t.divisional.losses,
+		DivisionTies:       t.divisional.ties,
+		ConferenceWins:     t.conferential.wins,
+		ConferenceLosses:   t.conferential.losses,
+		ConferenceTies:     t.conferential.ties,
+		HomeWins:           t.home.wins,
+		HomeLosses:         t.home.losses,
+		HomeTies:           t.home.ties,
+		AwayWins:           t.away.wins,
+		AwayLosses:         t.away.losses,
+		AwayTies:           t.away.ties,
+		StrengthOfVictory:  round(tb.strengthOfVictory(t.key)),
+		StrengthOfSchedule: round(tb.strengthOfSchedule(t.key)),
+	}
+}
+
+func round(value float64) float64 {
+	return math.Round(value*1000) / 1000
+}
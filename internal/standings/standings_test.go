@@ -0,0 +1,214 @@
+package standings
+
+import (
+	"testing"
+
+	"github.com/web-dev-jesus/trendzone/internal/db/models"
+)
+
+var testTeams = []models.Team{
+	{Key: "BUF", Conference: "AFC", Division: "East"},
+	{Key: "MIA", Conference: "AFC", Division: "East"},
+	{Key: "NE", Conference: "AFC", Division: "East"},
+	{Key: "NYJ", Conference: "AFC", Division: "East"},
+	{Key: "BAL", Conference: "AFC", Division: "North"},
+	{Key: "CIN", Conference: "AFC", Division: "North"},
+	{Key: "HOU", Conference: "AFC", Division: "South"},
+	{Key: "IND", Conference: "AFC", Division: "South"},
+	{Key: "DAL", Conference: "NFC", Division: "East"},
+	{Key: "PHI", Conference: "NFC", Division: "East"},
+}
+
+// final is a final regular season game won by the home team when homeScore is higher
+func final(week int, home string, away string, homeScore int, awayScore int) models.Game {
+	return models.Game{
+		Season:     2023,
+		SeasonType: 1,
+		Week:       week,
+		HomeTeam:   home,
+		AwayTeam:   away,
+		HomeScore:  homeScore,
+		AwayScore:  awayScore,
+		Status:     models.GameStatusFinal,
+	}
+}
+
+func byTeam(standings *Standings) map[string]TeamStanding {
+	teams := make(map[string]TeamStanding, len(standings.Teams))
+	for _, standing := range standings.Teams {
+		teams[standing.Team] = standing
+	}
+	return teams
+}
+
+func TestComputeDivisionTies(t *testing.T) {
+	tests := []struct {
+		name  string
+		games []models.Game
+		// order is the expected division order of its first teams, steps the tiebreaker reported for each
+		order []string
+		steps []string
+	}{
+		{
+			name: "two teams, head-to-head",
+			games: []models.Game{
+				final(1, "BUF", "MIA", 20, 10),
+				final(2, "MIA", "BAL", 24, 17),
+				final(2, "CIN", "BUF", 27, 13),
+			},
+			order: []string{"BUF", "MIA"},
+			steps: []string{StepHeadToHead, ""},
+		},
+		{
+			name: "two teams, split head-to-head falls to division record",
+			games: []models.Game{
+				final(1, "BUF", "MIA", 20, 10),
+				final(2, "MIA", "BUF", 23, 20),
+				final(3, "BUF", "NE", 31, 7),
+				final(3, "MIA", "BAL", 17, 14),
+				final(4, "CIN", "BUF", 21, 20),
+				final(4, "CIN", "MIA", 28, 3),
+			},
+			order: []string{"BUF", "MIA"},
+			steps: []string{StepDivisionRecord, ""},
+		},
+		{
+			name: "three teams, head-to-head then restart with two",
+			games: []models.Game{
+				final(1, "BUF", "MIA", 20, 10),
+				final(2, "BUF", "NE", 20, 10),
+				final(3, "MIA", "NE", 20, 10),
+				final(4, "BAL", "BUF", 20, 10),
+				final(5, "CIN", "BUF", 20, 10),
+				final(4, "MIA", "BAL", 20, 10),
+				final(5, "CIN", "MIA", 20, 10),
+				final(4, "NE", "BAL", 20, 10),
+				final(5, "NE", "CIN", 20, 10),
+			},
+			order: []string{"BUF", "MIA", "NE", "NYJ"},
+			steps: []string{StepHeadToHead, StepHeadToHead, "", ""},
+		},
+		{
+			name: "three teams, circular head-to-head falls to division record",
+			games: []models.Game{
+				final(1, "BUF", "MIA", 20, 10),
+				final(2, "MIA", "NE", 20, 10),
+				final(3, "NE", "BUF", 20, 10),
+				final(4, "BUF", "NYJ", 20, 10),
+				final(4, "MIA", "BAL", 20, 10),
+				final(4, "NE", "CIN", 20, 10),
+			},
+			order: []string{"BUF", "MIA", "NE", "NYJ"},
+			steps: []string{StepDivisionRecord, StepHeadToHead, "", ""},
+		},
+		{
+			name:  "no games, coin toss",
+			order: []string{"BUF", "MIA", "NE", "NYJ"},
+			steps: []string{StepCoinToss, StepCoinToss, StepCoinToss, ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teams := byTeam(Compute(2023, testTeams, tt.games))
+			for i, key := range tt.order {
+				standing := teams[key]
+				if standing.DivisionRank != i+1 {
+					t.Errorf("%s: division rank = %d, want %d", key, standing.DivisionRank, i+1)
+				}
+				if standing.DivisionTiebreaker != tt.steps[i] {
+					t.Errorf("%s: division tiebreaker = %q, want %q", key, standing.DivisionTiebreaker, tt.steps[i])
+				}
+				if standing.DivisionWinner != (i == 0) {
+					t.Errorf("%s: division winner = %v, want %v", key, standing.DivisionWinner, i == 0)
+				}
+			}
+		})
+	}
+}
+
+func TestComputeWildCardTies(t *testing.T) {
+	tests := []struct {
+		name  string
+		games []models.Game
+		// seeds are the expected seeds of the wild card teams, steps their conference tiebreakers
+		seeds map[string]int
+		steps map[string]string
+	}{
+		{
+			name: "two clubs, head-to-head",
+			games: []models.Game{
+				final(1, "BUF", "MIA", 20, 10),
+				final(1, "BAL", "CIN", 20, 10),
+				final(2, "MIA", "CIN", 20, 10),
+				final(3, "DAL", "MIA", 20, 10),
+				final(3, "CIN", "DAL", 20, 10),
+			},
+			seeds: map[string]int{"MIA": 4, "CIN": 5},
+			steps: map[string]string{"MIA": StepHeadToHead, "CIN": ""},
+		},
+		{
+			name: "three clubs, head-to-head sweep",
+			games: []models.Game{
+				final(1, "BUF", "MIA", 20, 10),
+				final(1, "BAL", "CIN", 20, 10),
+				final(1, "HOU", "IND", 20, 10),
+				final(2, "MIA", "CIN", 20, 10),
+				final(3, "MIA", "IND", 20, 10),
+				final(4, "DAL", "MIA", 20, 10),
+				final(2, "CIN", "DAL", 20, 10),
+				final(3, "CIN", "PHI", 20, 10),
+				final(4, "IND", "DAL", 20, 10),
+				final(5, "IND", "PHI", 20, 10),
+			},
+			seeds: map[string]int{"MIA": 4},
+			steps: map[string]string{"MIA": StepHeadToHeadSweep},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teams := byTeam(Compute(2023, testTeams, tt.games))
+			for key, seed := range tt.seeds {
+				if teams[key].Seed != seed {
+					t.Errorf("%s: seed = %d, want %d", key, teams[key].Seed, seed)
+				}
+			}
+			for key, step := range tt.steps {
+				if teams[key].ConferenceTiebreaker != step {
+					t.Errorf("%s: conference tiebreaker = %q, want %q", key, teams[key].ConferenceTiebreaker, step)
+				}
+			}
+		})
+	}
+}
+
+func TestComputeSeeds(t *testing.T) {
+	tests := []struct {
+		season int
+		seeded int
+		byes   int
+	}{
+		{season: 2019, seeded: 6, byes: 2},
+		{season: 2023, seeded: 7, byes: 1},
+	}
+
+	for _, tt := range tests {
+		standings := Compute(tt.season, testTeams, nil)
+		seeded, byes := 0, 0
+		for _, standing := range standings.Teams {
+			if standing.Conference != "AFC" {
+				continue
+			}
+			if standing.Seed > 0 {
+				seeded++
+			}
+			if standing.Bye {
+				byes++
+			}
+		}
+		if seeded != tt.seeded || byes != tt.byes {
+			t.Errorf("season %d: %d seeded with %d byes, want %d with %d", tt.season, seeded, byes, tt.seeded, tt.byes)
+		}
+	}
+}
@@ -0,0 +1,399 @@
+package standings
+
+import (
+	"sort"
+)
+
+// Tiebreaker steps, as reported in TeamStanding
+const (
+	StepHeadToHead           = "head_to_head"
+	StepHeadToHeadSweep      = "head_to_head_sweep"
+	StepDivisionRecord       = "division_record"
+	StepCommonGames          = "common_games"
+	StepConferenceRecord     = "conference_record"
+	StepStrengthOfVictory    = "strength_of_victory"
+	StepStrengthOfSchedule   = "strength_of_schedule"
+	StepConferencePointsRank = "conference_points_rank"
+	StepLeaguePointsRank     = "league_points_rank"
+	StepCommonNetPoints      = "common_games_net_points"
+	StepConferenceNetPoints  = "conference_net_points"
+	StepNetPoints            = "net_points"
+	// StepCoinToss stands in for the coin toss: the team with the lowest key wins
+	StepCoinToss = "coin_toss"
+)
+
+// minWildCardCommonGames is how many games against common opponents each team needs before the common
+// games step applies to a wild card tie
+const minWildCardCommonGames = 4
+
+// step is one tiebreaker. It scores the tied teams, higher is better, or returns nil if it doesn't
+// apply to them.
+type step struct {
+	name  string
+	score func(tb *table, group []string) map[string]float64
+}
+
+// divisionSteps break ties within a division, for two clubs or more
+var divisionSteps = []step{
+	{StepHeadToHead, (*table).headToHead},
+	{StepDivisionRecord, func(tb *table, group []string) map[string]float64 {
+		return tb.scores(group, func(t *team) float64 { return t.divisional.pct() })
+	}},
+	{StepCommonGames, func(tb *table, group []string) map[string]float64 { return tb.commonGames(group, 1) }},
+	{StepConferenceRecord, (*table).conferenceRecord},
+	{StepStrengthOfVictory, (*table).strengthOfVictoryScores},
+	{StepStrengthOfSchedule, (*table).strengthOfScheduleScores},
+	{StepConferencePointsRank, (*table).conferencePointsRankScores},
+	{StepLeaguePointsRank, (*table).leaguePointsRankScores},
+	{StepCommonNetPoints, (*table).commonNetPoints},
+	{StepNetPoints, (*table).netPoints},
+}
+
+// wildCardSteps break ties between clubs of different divisions, for wild cards and for seeding the
+// division winners. Two clubs start with head-to-head, three or more with a head-to-head sweep.
+func wildCardSteps(clubs int) []step {
+	first := step{StepHeadToHead, (*table).headToHead}
+	if clubs > 2 {
+		first = step{StepHeadToHeadSweep, (*table).headToHeadSweep}
+	}
+	return []step{
+		first,
+		{StepConferenceRecord, (*table).conferenceRecord},
+		{StepCommonGames, func(tb *table, group []string) map[string]float64 {
+			return tb.commonGames(group, minWildCardCommonGames)
+		}},
+		{StepStrengthOfVictory, (*table).strengthOfVictoryScores},
+		{StepStrengthOfSchedule, (*table).strengthOfScheduleScores},
+		{StepConferencePointsRank, (*table).conferencePointsRankScores},
+		{StepLeaguePointsRank, (*table).leaguePointsRankScores},
+		{StepConferenceNetPoints, (*table).conferenceNetPoints},
+		{StepNetPoints, (*table).netPoints},
+	}
+}
+
+// order ranks teams by percentage. Each group of tied teams is broken by tie one team at a time:
+// once the best of them is found, the others start over. The returned steps name the tiebreaker that
+// ranked each team above the teams it was tied with.
+func (tb *table) order(keys []string, tie func(group []string) (string, string)) ([]string, map[string]string) {
+	remaining := append([]string(nil), keys...)
+	sort.Strings(remaining)
+
+	order := make([]string, 0, len(keys))
+	steps := map[string]string{}
+	for len(remaining) > 0 {
+		best := -1.0
+		var tied []string
+		for _, key := range remaining {
+			switch pct := tb.teams[key].overall.pct(); {
+			case pct > best:
+				best, tied = pct, []string{key}
+			case pct == best:
+				tied = append(tied, key)
+			}
+		}
+
+		winner := tied[0]
+		if len(tied) > 1 {
+			var step string
+			winner, step = tie(tied)
+			steps[winner] = step
+		}
+		order = append(order, winner)
+
+		for i, key := range remaining {
+			if key == winner {
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				break
+			}
+		}
+	}
+	return order, steps
+}
+
+// divisionTie finds the best of teams of one division that are tied
+func (tb *table) divisionTie(group []string) (string, string) {
+	return tb.breakTie(group, func(int) []step { return divisionSteps })
+}
+
+// wildCardTie finds the best of tied teams of one conference. Only the highest ranked team of each
+// division takes part; the others can't be ahead of it.
+func (tb *table) wildCardTie(group []string) (string, string) {
+	byDivision := map[string]string{}
+	for _, key := range group {
+		t := tb.teams[key]
+		if best, ok := byDivision[t.division]; !ok || t.standing.DivisionRank < tb.teams[best].standing.DivisionRank {
+			byDivision[t.division] = key
+		}
+	}
+
+	if len(byDivision) == 1 {
+		for _, key := range byDivision {
+			return key, tb.teams[key].standing.DivisionTiebreaker
+		}
+	}
+
+	representatives := make([]string, 0, len(byDivision))
+	for _, key := range byDivision {
+		representatives = append(representatives, key)
+	}
+	sort.Strings(representatives)
+	return tb.breakTie(representatives, wildCardSteps)
+}
+
+// breakTie applies the steps in order until one separates the group. If that leaves more than one team
+// on top, those teams start over at the first step.
+func (tb *table) breakTie(group []string, steps func(clubs int) []step) (string, string) {
+	for {
+		separated := false
+		for _, s := range steps(len(group)) {
+			best := bestOf(group, s.score(tb, group))
+			if len(best) == len(group) {
+				continue
+			}
+			if len(best) == 1 {
+				return best[0], s.name
+			}
+			group, separated = best, true
+			break
+		}
+		if !separated {
+			return group[0], StepCoinToss
+		}
+	}
+}
+
+// bestOf returns the teams with the highest score, or the whole group without scores
+func bestOf(group []string, scores map[string]float64) []string {
+	if scores == nil {
+		return group
+	}
+
+	var best []string
+	for _, key := range group {
+		switch {
+		case len(best) == 0 || scores[key] > scores[best[0]]:
+			best = []string{key}
+		case scores[key] == scores[best[0]]:
+			best = append(best, key)
+		}
+	}
+	return best
+}
+
+// scores scores every team of the group with value
+func (tb *table) scores(group []string, value func(t *team) float64) map[string]float64 {
+	scores := make(map[string]float64, len(group))
+	for _, key := range group {
+		scores[key] = value(tb.teams[key])
+	}
+	return scores
+}
+
+// against totals a team's results against some opponents
+func (tb *table) against(key string, opponents map[string]bool) (rec record, net int) {
+	for _, r := range tb.teams[key].results {
+		if opponents[r.opponent] {
+			rec.add(r.pointsFor, r.pointsAgainst)
+			net += r.pointsFor - r.pointsAgainst
+		}
+	}
+	return rec, net
+}
+
+// headToHead scores the percentage in games among the group; it applies once every team played another
+func (tb *table) headToHead(group []string) map[string]float64 {
+	members := set(group)
+	scores := make(map[string]float64, len(group))
+	for _, key := range group {
+		rec, _ := tb.against(key, members)
+		if rec.games() == 0 {
+			return nil
+		}
+		scores[key] = rec.pct()
+	}
+	return scores
+}
+
+// headToHeadSweep applies when one team beat each of the others, which wins, or lost to each of the
+// others, which drops out
+func (tb *table) headToHeadSweep(group []string) map[string]float64 {
+	for _, sign := range []float64{1, -1} {
+		for _, key := range group {
+			swept := true
+			for _, other := range group {
+				if other == key {
+					continue
+				}
+				rec, _ := tb.against(key, set([]string{other}))
+				beat := rec.wins > 0 && rec.losses == 0 && rec.ties == 0
+				lost := rec.losses > 0 && rec.wins == 0 && rec.ties == 0
+				if (sign > 0 && !beat) || (sign < 0 && !lost) {
+					swept = false
+					break
+				}
+			}
+			if swept {
+				scores := make(map[string]float64, len(group))
+				scores[key] = sign
+				return scores
+			}
+		}
+	}
+	return nil
+}
+
+func (tb *table) conferenceRecord(group []string) map[string]float64 {
+	return tb.scores(group, func(t *team) float64 { return t.conferential.pct() })
+}
+
+// commonOpponents returns the opponents every team of the group played
+func (tb *table) commonOpponents(group []string) map[string]bool {
+	var common map[string]bool
+	for _, key := range group {
+		opponents := map[string]bool{}
+		for _, r := range tb.teams[key].results {
+			if common == nil || common[r.opponent] {
+				opponents[r.opponent] = true
+			}
+		}
+		common = opponents
+	}
+	return common
+}
+
+// commonGames scores the percentage against common opponents; it applies once every team played at
+// least min games against them
+func (tb *table) commonGames(group []string, min int) map[string]float64 {
+	common := tb.commonOpponents(group)
+	scores := make(map[string]float64, len(group))
+	for _, key := range group {
+		rec, _ := tb.against(key, common)
+		if rec.games() < min {
+			return nil
+		}
+		scores[key] = rec.pct()
+	}
+	return scores
+}
+
+// commonNetPoints scores the net points against common opponents
+func (tb *table) commonNetPoints(group []string) map[string]float64 {
+	common := tb.commonOpponents(group)
+	if len(common) == 0 {
+		return nil
+	}
+	scores := make(map[string]float64, len(group))
+	for _, key := range group {
+		_, net := tb.against(key, common)
+		scores[key] = float64(net)
+	}
+	return scores
+}
+
+// conferenceNetPoints scores the net points in conference games
+func (tb *table) conferenceNetPoints(group []string) map[string]float64 {
+	scores := make(map[string]float64, len(group))
+	for _, key := range group {
+		t := tb.teams[key]
+		net := 0
+		for _, r := range t.results {
+			if tb.teams[r.opponent].conference == t.conference {
+				net += r.pointsFor - r.pointsAgainst
+			}
+		}
+		scores[key] = float64(net)
+	}
+	return scores
+}
+
+func (tb *table) netPoints(group []string) map[string]float64 {
+	return tb.scores(group, func(t *team) float64 { return float64(t.pointsFor - t.pointsAgainst) })
+}
+
+// strengthOfVictory is the combined percentage of the opponents a team beat, counting an opponent
+// once per win
+func (tb *table) strengthOfVictory(key string) float64 {
+	var combined record
+	for _, r := range tb.teams[key].results {
+		if r.pointsFor > r.pointsAgainst {
+			combined = combined.plus(tb.teams[r.opponent].overall)
+		}
+	}
+	return combined.pct()
+}
+
+// strengthOfSchedule is the combined percentage of a team's opponents, counting an opponent once per game
+func (tb *table) strengthOfSchedule(key string) float64 {
+	var combined record
+	for _, r := range tb.teams[key].results {
+		combined = combined.plus(tb.teams[r.opponent].overall)
+	}
+	return combined.pct()
+}
+
+func (r record) plus(other record) record {
+	return record{wins: r.wins + other.wins, losses: r.losses + other.losses, ties: r.ties + other.ties}
+}
+
+func (tb *table) strengthOfVictoryScores(group []string) map[string]float64 {
+	return tb.scores(group, func(t *team) float64 { return tb.strengthOfVictory(t.key) })
+}
+
+func (tb *table) strengthOfScheduleScores(group []string) map[string]float64 {
+	return tb.scores(group, func(t *team) float64 { return tb.strengthOfSchedule(t.key) })
+}
+
+// conferencePointsRankScores scores the combined ranking among the conference's teams in points scored
+// and points allowed, a lower ranking being better
+func (tb *table) conferencePointsRankScores(group []string) map[string]float64 {
+	if tb.conferencePointsRank == nil {
+		byConference := map[string][]string{}
+		for _, key := range tb.keys {
+			conference := tb.teams[key].conference
+			byConference[conference] = append(byConference[conference], key)
+		}
+		tb.conferencePointsRank = map[string]int{}
+		for _, keys := range byConference {
+			for key, rank := range tb.pointsRanks(keys) {
+				tb.conferencePointsRank[key] = rank
+			}
+		}
+	}
+	return tb.scores(group, func(t *team) float64 { return -float64(tb.conferencePointsRank[t.key]) })
+}
+
+// leaguePointsRankScores scores the combined ranking among all teams in points scored and points allowed
+func (tb *table) leaguePointsRankScores(group []string) map[string]float64 {
+	if tb.leaguePointsRank == nil {
+		tb.leaguePointsRank = tb.pointsRanks(tb.keys)
+	}
+	return tb.scores(group, func(t *team) float64 { return -float64(tb.leaguePointsRank[t.key]) })
+}
+
+// pointsRanks sums each team's rank in points scored, most first, and in points allowed, fewest first.
+// Teams with equal points share the higher rank.
+func (tb *table) pointsRanks(keys []string) map[string]int {
+	ranks := make(map[string]int, len(keys))
+	for _, key := range keys {
+		t := tb.teams[key]
+		for _, other := range keys {
+			o := tb.teams[other]
+			if o.pointsFor > t.pointsFor {
+				ranks[key]++
+			}
+			if o.pointsAgainst < t.pointsAgainst {
+				ranks[key]++
+			}
+		}
+		ranks[key] += 2
+	}
+	return ranks
+}
+
+func set(keys []string) map[string]bool {
+	members := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		members[key] = true
+	}
+	return members
+}